@@ -1,19 +1,56 @@
 package main
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"os"
 
 	"avito-tenders/config"
 	"avito-tenders/internal/app"
 )
 
+const usage = `usage: webserver [command]
+
+commands:
+  serve     run HTTP server, default command
+  migrate   manage database migrations, see "webserver migrate -h"
+  seed      insert generated demo data, see "webserver seed -h"`
+
 func main() {
-	// Configuration
-	cfg, err := config.NewConfig()
-	if err != nil {
-		log.Fatalf("Config error: %s", err)
+	command, args := "serve", os.Args[1:]
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
 	}
 
-	// Run
-	app.Run(cfg)
+	switch command {
+	case "serve":
+		// Configuration
+		cfg, err := config.NewConfig()
+		if err != nil {
+			log.Fatalf("Config error: %s", err)
+		}
+
+		// Run
+		app.Run(cfg)
+	case "migrate":
+		if err := app.RunMigrate(args); err != nil {
+			if errors.Is(err, app.ErrUsage) {
+				os.Exit(2)
+			}
+
+			log.Fatalf("Migrate error: %s", err)
+		}
+	case "seed":
+		if err := app.RunSeed(args); err != nil {
+			if errors.Is(err, app.ErrUsage) {
+				os.Exit(2)
+			}
+
+			log.Fatalf("Seed error: %s", err)
+		}
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
 }
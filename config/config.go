@@ -2,26 +2,114 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 )
 
+// configFileEnv is the env variable with path to the optional YAML config file.
+const configFileEnv = "CONFIG_FILE"
+
 // Config is the structure that represents configuration for webserver.
+//
+// Values are taken from env variables, then from the YAML config file, then from defaults. Fields tagged with
+// `secret:"true"` are redacted in Dump.
 type Config struct {
-	ServerAddress    string `env:"SERVER_ADDRESS,required"`
-	PostgresConn     string `env:"POSTGRES_CONN,required"`
+	ServerAddress string `env:"SERVER_ADDRESS,required"`
+
+	// ServerReadTimeout, ServerWriteTimeout and ServerShutdownTimeout configure the API http.Server.
+	ServerReadTimeout     time.Duration `env:"SERVER_READ_TIMEOUT" envDefault:"5s"`
+	ServerWriteTimeout    time.Duration `env:"SERVER_WRITE_TIMEOUT" envDefault:"5s"`
+	ServerShutdownTimeout time.Duration `env:"SERVER_SHUTDOWN_TIMEOUT" envDefault:"3s"`
+
+	// ServerDrainDelay is how long the server keeps serving after readiness starts failing on shutdown.
+	ServerDrainDelay time.Duration `env:"SERVER_DRAIN_DELAY" envDefault:"0s"`
+
+	// CORSAllowedOrigins and CORSAllowedMethods are passed to the CORS middleware of the API router.
+	CORSAllowedOrigins []string `env:"CORS_ALLOWED_ORIGINS" envSeparator:"," envDefault:"*"`
+	CORSAllowedMethods []string `env:"CORS_ALLOWED_METHODS" envSeparator:"," envDefault:"GET"`
+
+	PostgresConn     string `env:"POSTGRES_CONN,required" secret:"true"`
 	PostgresUsername string `env:"POSTGRES_USERNAME,required"`
-	PostgresPassword string `env:"POSTGRES_PASSWORD,required"`
+	PostgresPassword string `env:"POSTGRES_PASSWORD,required" secret:"true"`
 	PostgresHost     string `env:"POSTGRES_HOST,required"`
 	PostgresPort     int    `env:"POSTGRES_PORT,required"`
 	PostgresDatabase string `env:"POSTGRES_DATABASE,required"`
+
+	// PostgresMaxOpenConns, PostgresMaxIdleConns and PostgresConnMaxLifetime configure the connection pool.
+	PostgresMaxOpenConns    int           `env:"POSTGRES_MAX_OPEN_CONNS" envDefault:"30"`
+	PostgresMaxIdleConns    int           `env:"POSTGRES_MAX_IDLE_CONNS" envDefault:"15"`
+	PostgresConnMaxLifetime time.Duration `env:"POSTGRES_CONN_MAX_LIFETIME" envDefault:"3m"`
+
+	// PostgresConnectAttempts is how many times connection is tried on startup, waiting PostgresConnectInterval between.
+	PostgresConnectAttempts int           `env:"POSTGRES_CONNECT_ATTEMPTS" envDefault:"3"`
+	PostgresConnectInterval time.Duration `env:"POSTGRES_CONNECT_INTERVAL" envDefault:"1s"`
+
+	// MigrateOnStart applies pending migrations before the server starts, otherwise "migrate up" command is used.
+	MigrateOnStart bool `env:"MIGRATE_ON_START"`
+
+	// OpenAPIValidation checks API requests and responses against the OpenAPI spec and logs divergences.
+	OpenAPIValidation bool `env:"OPENAPI_VALIDATION"`
+
+	// LogLevel is the minimal level of logged messages: "debug", "info", "warn" or "error".
+	LogLevel string `env:"LOG_LEVEL" envDefault:"info"`
+
+	// MetricsAddress is the address of the metrics listener, it is separate from the API one. Empty value disables it.
+	MetricsAddress string `env:"METRICS_ADDRESS" envDefault:":9090"`
+
+	// GRPCAddress is the address of the gRPC API listener. Empty value disables it.
+	GRPCAddress string `env:"GRPC_ADDRESS" envDefault:":50051"`
+
+	// TracingExporter selects where spans are exported: "none", "stdout" or "otlp".
+	TracingExporter     string  `env:"TRACING_EXPORTER" envDefault:"none"`
+	TracingServiceName  string  `env:"TRACING_SERVICE_NAME" envDefault:"avito-tenders"`
+	TracingOTLPEndpoint string  `env:"TRACING_OTLP_ENDPOINT"`
+	TracingOTLPInsecure bool    `env:"TRACING_OTLP_INSECURE"`
+	TracingSampleRatio  float64 `env:"TRACING_SAMPLE_RATIO" envDefault:"1"`
+
+	// StorageDriver selects attachments storage: "local" or "s3".
+	StorageDriver      string `env:"STORAGE_DRIVER" envDefault:"local"`
+	StorageLocalPath   string `env:"STORAGE_LOCAL_PATH" envDefault:"attachments"`
+	StorageS3Endpoint  string `env:"STORAGE_S3_ENDPOINT"`
+	StorageS3Bucket    string `env:"STORAGE_S3_BUCKET"`
+	StorageS3AccessKey string `env:"STORAGE_S3_ACCESS_KEY" secret:"true"`
+	StorageS3SecretKey string `env:"STORAGE_S3_SECRET_KEY" secret:"true"`
+	StorageS3UseSSL    bool   `env:"STORAGE_S3_USE_SSL" envDefault:"true"`
+
+	AttachmentMaxSize      int64    `env:"ATTACHMENT_MAX_SIZE" envDefault:"10485760"`
+	AttachmentAllowedTypes []string `env:"ATTACHMENT_ALLOWED_TYPES" envSeparator:"," envDefault:"application/pdf,application/zip,image/png,image/jpeg,text/plain"`
 }
 
+// NewConfig reads config from env variables layered over the optional YAML file from CONFIG_FILE and validates it.
 func NewConfig() (*Config, error) {
-	cfg, err := env.ParseAs[Config]()
+	return Load(os.Getenv(configFileEnv), env.ToMap(os.Environ()))
+}
+
+// Load reads config from the given environment layered over the YAML file at path, empty path means no file.
+func Load(path string, environment map[string]string) (*Config, error) {
+	values := map[string]string{}
+	if path != "" {
+		fileValues, err := readFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config file: %w", err)
+		}
+
+		values = fileValues
+	}
+
+	for k, v := range environment {
+		values[k] = v
+	}
+
+	cfg, err := env.ParseAsWithOptions[Config](env.Options{Environment: values})
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
 
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
 	return &cfg, nil
 }
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// Dump returns effective config keyed by env variable names with secrets redacted. Empty secrets are kept empty, so
+// it is visible that they are not set.
+func (c *Config) Dump() map[string]string {
+	values := map[string]string{}
+
+	v := reflect.ValueOf(c).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		name, _, _ := strings.Cut(field.Tag.Get("env"), ",")
+		if name == "" {
+			continue
+		}
+
+		value := v.Field(i).Interface()
+		if items, ok := value.([]string); ok {
+			value = strings.Join(items, ",")
+		}
+
+		values[name] = fmt.Sprint(value)
+		if field.Tag.Get("secret") == "true" && values[name] != "" {
+			values[name] = redacted
+		}
+	}
+
+	return values
+}
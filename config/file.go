@@ -0,0 +1,69 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// readFile reads YAML config file and flattens it to env variables, so nested keys are joined with underscore:
+//
+//	server:
+//	  read_timeout: 10s
+//	cors:
+//	  allowed_origins: [https://example.com]
+//
+// becomes SERVER_READ_TIMEOUT=10s and CORS_ALLOWED_ORIGINS=https://example.com.
+func readFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	values := map[string]string{}
+	if err := flatten(values, "", doc); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return values, nil
+}
+
+func flatten(values map[string]string, prefix string, v any) error {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			name := strings.ToUpper(key)
+			if prefix != "" {
+				name = prefix + "_" + name
+			}
+
+			if err := flatten(values, name, nested); err != nil {
+				return err
+			}
+		}
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			switch item.(type) {
+			case map[string]any, []any:
+				return fmt.Errorf("%s: lists may contain only scalar values", prefix)
+			}
+
+			items = append(items, fmt.Sprint(item))
+		}
+
+		values[prefix] = strings.Join(items, ",")
+	case nil:
+	default:
+		values[prefix] = fmt.Sprint(v)
+	}
+
+	return nil
+}
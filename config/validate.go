@@ -0,0 +1,32 @@
+package config
+
+import (
+	"github.com/invopop/validation"
+)
+
+// Validate checks that config values are consistent.
+func (c *Config) Validate() error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.ServerAddress, validation.Required),
+		validation.Field(&c.ServerReadTimeout, validation.Min(0)),
+		validation.Field(&c.ServerWriteTimeout, validation.Min(0)),
+		validation.Field(&c.ServerShutdownTimeout, validation.Required, validation.Min(0)),
+		validation.Field(&c.ServerDrainDelay, validation.Min(0)),
+		validation.Field(&c.CORSAllowedOrigins, validation.Required),
+		validation.Field(&c.CORSAllowedMethods, validation.Required),
+		validation.Field(&c.PostgresPort, validation.Required, validation.Min(1), validation.Max(65535)),
+		validation.Field(&c.PostgresMaxOpenConns, validation.Required, validation.Min(1)),
+		validation.Field(&c.PostgresMaxIdleConns, validation.Min(0), validation.Max(c.PostgresMaxOpenConns).
+			Error("must be no greater than POSTGRES_MAX_OPEN_CONNS")),
+		validation.Field(&c.PostgresConnMaxLifetime, validation.Min(0)),
+		validation.Field(&c.PostgresConnectAttempts, validation.Required, validation.Min(1)),
+		validation.Field(&c.PostgresConnectInterval, validation.Required, validation.Min(0)),
+		validation.Field(&c.LogLevel, validation.In("debug", "info", "warn", "error")),
+		validation.Field(&c.TracingExporter, validation.In("none", "stdout", "otlp")),
+		validation.Field(&c.TracingSampleRatio, validation.Min(0.0), validation.Max(1.0)),
+		validation.Field(&c.StorageDriver, validation.In("local", "s3")),
+		validation.Field(&c.StorageS3Bucket, validation.When(c.StorageDriver == "s3", validation.Required)),
+		validation.Field(&c.StorageS3Endpoint, validation.When(c.StorageDriver == "s3", validation.Required)),
+		validation.Field(&c.AttachmentMaxSize, validation.Min(int64(1))),
+	)
+}
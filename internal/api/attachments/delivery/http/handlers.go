@@ -0,0 +1,178 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"avito-tenders/internal/api/attachments"
+	"avito-tenders/internal/api/attachments/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+const (
+	fileFormField = "file"
+
+	// multipartOverhead is the allowance for multipart headers and boundaries on top of file size limit.
+	multipartOverhead = 1 << 20
+)
+
+type Handlers struct {
+	uc      attachments.Usecase
+	maxSize int64
+}
+
+func NewHandlers(uc attachments.Usecase, maxSize int64) *Handlers {
+	return &Handlers{uc: uc, maxSize: maxSize}
+}
+
+func (h *Handlers) Upload(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxSize+multipartOverhead)
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		apperror.SendError(w, apperror.BadRequest(errors.New("request must be multipart/form-data")))
+		return
+	}
+
+	// Find the file part, other parts are ignored.
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				apperror.SendError(w, apperror.BadRequest(errors.New("file is not specified")))
+				return
+			}
+
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				apperror.SendError(w, apperror.RequestEntityTooLarge(errors.New("file is too large")))
+				return
+			}
+
+			apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+			return
+		}
+
+		if part.FormName() != fileFormField {
+			part.Close()
+			continue
+		}
+
+		request := dtos.UploadRequest{
+			OwnerType: entity.AttachmentOwnerType(chi.URLParam(r, ownerTypePathParam)),
+			OwnerID:   chi.URLParam(r, ownerIDPathParam),
+			Username:  fwcontext.GetUsername(r.Context()),
+			FileName:  part.FileName(),
+			Content:   part,
+		}
+		if err := request.Validate(); err != nil {
+			apperror.SendError(w, apperror.BadRequest(err))
+			return
+		}
+
+		attachment, err := h.uc.Upload(r.Context(), request)
+		if err != nil {
+			apperror.SendError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(attachment); err != nil {
+			apperror.SendError(w, apperror.InternalServerError(err))
+		}
+
+		return
+	}
+}
+
+func (h *Handlers) FindByOwner(w http.ResponseWriter, r *http.Request) {
+	request := dtos.FindByOwnerRequest{
+		OwnerType: entity.AttachmentOwnerType(chi.URLParam(r, ownerTypePathParam)),
+		OwnerID:   chi.URLParam(r, ownerIDPathParam),
+		Username:  fwcontext.GetUsername(r.Context()),
+	}
+
+	if version := r.URL.Query().Get("version"); version != "" {
+		versionInt, err := strconv.Atoi(version)
+		if err != nil {
+			apperror.SendError(w, apperror.BadRequest(errors.New("version is not a number")))
+			return
+		}
+
+		request.Version = &versionInt
+	}
+
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	attachmentList, err := h.uc.FindByOwner(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(attachmentList); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) Download(w http.ResponseWriter, r *http.Request) {
+	request := dtos.DownloadRequest{
+		AttachmentID: chi.URLParam(r, attachmentIDPathParam),
+		Username:     fwcontext.GetUsername(r.Context()),
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	download, err := h.uc.Download(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+	defer download.Content.Close()
+
+	attachment := download.Attachment
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+	w.Header().Set("ETag", fmt.Sprintf("%q", attachment.SHA256))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, download.Content); err != nil {
+		fwcontext.GetLogger(r.Context()).Error("failed to send attachment content", "attachment", attachment.ID, "error", err)
+	}
+}
+
+func (h *Handlers) Remove(w http.ResponseWriter, r *http.Request) {
+	request := dtos.RemoveRequest{
+		AttachmentID: chi.URLParam(r, attachmentIDPathParam),
+		Username:     fwcontext.GetUsername(r.Context()),
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	if err := h.uc.Remove(r.Context(), request); err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,25 @@
+package http
+
+import (
+	"fmt"
+
+	"github.com/go-chi/chi/v5"
+
+	"avito-tenders/internal/api/middlewares"
+)
+
+const (
+	ownerTypePathParam    = "ownerType"
+	ownerIDPathParam      = "ownerId"
+	attachmentIDPathParam = "attachmentId"
+)
+
+func (h *Handlers) MapAttachmentsRoutes(r chi.Router, mw *middlewares.Manager) {
+	r.Route("/attachments", func(r chi.Router) {
+		r.Get(fmt.Sprintf("/{%s}/download", attachmentIDPathParam), middlewares.Conveyor(h.Download, mw.UserExistsMiddleware))
+		r.Delete(fmt.Sprintf("/{%s}", attachmentIDPathParam), middlewares.Conveyor(h.Remove, mw.UserExistsMiddleware))
+
+		r.Get(fmt.Sprintf("/{%s}/{%s}", ownerTypePathParam, ownerIDPathParam), middlewares.Conveyor(h.FindByOwner, mw.UserExistsMiddleware))
+		r.Post(fmt.Sprintf("/{%s}/{%s}", ownerTypePathParam, ownerIDPathParam), middlewares.Conveyor(h.Upload, mw.UserExistsMiddleware))
+	})
+}
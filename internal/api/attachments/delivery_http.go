@@ -0,0 +1,10 @@
+package attachments
+
+import "net/http"
+
+type HTTPHandlers interface {
+	Upload(w http.ResponseWriter, r *http.Request)
+	FindByOwner(w http.ResponseWriter, r *http.Request)
+	Download(w http.ResponseWriter, r *http.Request)
+	Remove(w http.ResponseWriter, r *http.Request)
+}
@@ -0,0 +1,108 @@
+package dtos
+
+import (
+	"io"
+
+	"github.com/invopop/validation"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/types"
+)
+
+type UploadRequest struct {
+	OwnerType entity.AttachmentOwnerType
+	OwnerID   string
+	Username  string
+	FileName  string
+	Content   io.Reader
+}
+
+func (r UploadRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.OwnerType, validation.Required, r.OwnerType.ValidationRule()),
+		validation.Field(&r.OwnerID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required),
+		validation.Field(&r.FileName, validation.Required, validation.Length(1, 255)),
+	)
+}
+
+type FindByOwnerRequest struct {
+	OwnerType entity.AttachmentOwnerType
+	OwnerID   string
+	Username  string
+	// Version of the owner, current version is used if it is nil.
+	Version *int
+}
+
+func (r FindByOwnerRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.OwnerType, validation.Required, r.OwnerType.ValidationRule()),
+		validation.Field(&r.OwnerID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required),
+		validation.Field(&r.Version, validation.NilOrNotEmpty, validation.Min(1)),
+	)
+}
+
+type DownloadRequest struct {
+	AttachmentID string
+	Username     string
+}
+
+func (r DownloadRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.AttachmentID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required),
+	)
+}
+
+type DownloadResponse struct {
+	Attachment AttachmentResponse
+	Content    io.ReadCloser
+}
+
+type RemoveRequest struct {
+	AttachmentID string
+	Username     string
+}
+
+func (r RemoveRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.AttachmentID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required),
+	)
+}
+
+type AttachmentResponse struct {
+	ID          string                     `json:"id"`
+	OwnerType   entity.AttachmentOwnerType `json:"ownerType"`
+	OwnerID     string                     `json:"ownerId"`
+	FileName    string                     `json:"fileName"`
+	ContentType string                     `json:"contentType"`
+	Size        int64                      `json:"size"`
+	SHA256      string                     `json:"sha256"`
+	Version     int                        `json:"version"`
+	CreatedAt   types.RFC3339Time          `json:"createdAt"`
+}
+
+func NewAttachmentResponse(attachment entity.Attachment) AttachmentResponse {
+	return AttachmentResponse{
+		ID:          attachment.ID,
+		OwnerType:   attachment.OwnerType,
+		OwnerID:     attachment.OwnerID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		SHA256:      attachment.SHA256,
+		Version:     attachment.VersionFrom,
+		CreatedAt:   types.RFCFromTime(attachment.CreatedAt),
+	}
+}
+
+func NewAttachmentResponseList(attachmentList []entity.Attachment) []AttachmentResponse {
+	result := make([]AttachmentResponse, 0, len(attachmentList))
+	for _, attachment := range attachmentList {
+		result = append(result, NewAttachmentResponse(attachment))
+	}
+
+	return result
+}
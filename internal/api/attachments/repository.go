@@ -0,0 +1,21 @@
+package attachments
+
+import (
+	"context"
+
+	"avito-tenders/internal/entity"
+)
+
+type Repository interface {
+	Create(ctx context.Context, attachment entity.Attachment) (entity.Attachment, error)
+	FindByID(ctx context.Context, id string) (entity.Attachment, error)
+
+	// FindByOwner returns attachments that belong to the given version of the owner.
+	FindByOwner(ctx context.Context, ownerType entity.AttachmentOwnerType, ownerID string, version int) ([]entity.Attachment, error)
+
+	// Remove detaches attachment starting from the given owner version.
+	Remove(ctx context.Context, id string, version int) error
+
+	// RestoreVersion makes attachments of the old owner version current again for the new owner version.
+	RestoreVersion(ctx context.Context, ownerType entity.AttachmentOwnerType, ownerID string, oldVersion, newVersion int) error
+}
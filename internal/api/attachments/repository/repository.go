@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+type Repository struct {
+	db     *sqlx.DB
+	getter *trmsqlx.CtxGetter
+}
+
+func NewRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) *Repository {
+	return &Repository{db: db, getter: getter}
+}
+
+func (r Repository) Create(ctx context.Context, attachment entity.Attachment) (entity.Attachment, error) {
+	row := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx, `
+		insert into attachments(owner_type, owner_id, file_name, content_type, size, sha256, storage_key, uploaded_by, version_from)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		returning id, owner_type, owner_id, file_name, content_type, size, sha256, storage_key, uploaded_by, version_from, version_to, created_at`,
+		attachment.OwnerType,
+		attachment.OwnerID,
+		attachment.FileName,
+		attachment.ContentType,
+		attachment.Size,
+		attachment.SHA256,
+		attachment.StorageKey,
+		attachment.UploadedBy,
+		attachment.VersionFrom)
+	if row.Err() != nil {
+		fwcontext.GetLogger(ctx).Error("failed to insert attachment", "error", row.Err())
+		return entity.Attachment{}, apperror.BadRequest(apperror.ErrInvalidInput)
+	}
+
+	var result entity.Attachment
+	if err := row.StructScan(&result); err != nil {
+		fwcontext.GetLogger(ctx).Error("failed to scan created attachment", "error", err)
+		return entity.Attachment{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return result, nil
+}
+
+func (r Repository) FindByID(ctx context.Context, id string) (entity.Attachment, error) {
+	row := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx, `
+		select id, owner_type, owner_id, file_name, content_type, size, sha256, storage_key, uploaded_by, version_from, version_to, created_at
+		from attachments
+		where id = $1`, id)
+	if row.Err() != nil {
+		return entity.Attachment{}, apperror.BadRequest(apperror.ErrInvalidInput)
+	}
+
+	var attachment entity.Attachment
+	if err := row.StructScan(&attachment); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Attachment{}, apperror.NotFound(apperror.ErrNotFound)
+		}
+
+		fwcontext.GetLogger(ctx).Error("failed to scan attachment", "error", err)
+
+		return entity.Attachment{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return attachment, nil
+}
+
+func (r Repository) FindByOwner(ctx context.Context, ownerType entity.AttachmentOwnerType, ownerID string, version int) ([]entity.Attachment, error) {
+	attachmentList := make([]entity.Attachment, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &attachmentList, `
+		select id, owner_type, owner_id, file_name, content_type, size, sha256, storage_key, uploaded_by, version_from, version_to, created_at
+		from attachments
+		where owner_type = $1 and owner_id = $2
+		  and version_from <= $3 and (version_to is null or version_to > $3)
+		order by created_at, file_name`,
+		ownerType, ownerID, version)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("failed to find attachments by owner", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return attachmentList, nil
+}
+
+func (r Repository) Remove(ctx context.Context, id string, version int) error {
+	result, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, `
+		update attachments set version_to = $2
+		where id = $1 and version_to is null`,
+		id, version)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("failed to remove attachment", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("failed to get removed attachments count", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
+	}
+	if affected == 0 {
+		return apperror.NotFound(apperror.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r Repository) RestoreVersion(ctx context.Context, ownerType entity.AttachmentOwnerType, ownerID string, oldVersion, newVersion int) error {
+	tr := r.getter.DefaultTrOrDB(ctx, r.db)
+
+	// Detach current attachments that were added after the old version.
+	_, err := tr.ExecContext(ctx, `
+		update attachments set version_to = $4
+		where owner_type = $1 and owner_id = $2 and version_to is null and version_from > $3`,
+		ownerType, ownerID, oldVersion, newVersion)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("failed to detach attachments on restore", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	// Attach again attachments of the old version that were removed since then.
+	_, err = tr.ExecContext(ctx, `
+		insert into attachments(owner_type, owner_id, file_name, content_type, size, sha256, storage_key, uploaded_by, version_from)
+		select owner_type, owner_id, file_name, content_type, size, sha256, storage_key, uploaded_by, $4
+		from attachments
+		where owner_type = $1 and owner_id = $2 and version_from <= $3 and version_to is not null and version_to > $3`,
+		ownerType, ownerID, oldVersion, newVersion)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("failed to reattach attachments on restore", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return nil
+}
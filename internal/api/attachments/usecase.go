@@ -0,0 +1,14 @@
+package attachments
+
+import (
+	"context"
+
+	"avito-tenders/internal/api/attachments/dtos"
+)
+
+type Usecase interface {
+	Upload(ctx context.Context, req dtos.UploadRequest) (dtos.AttachmentResponse, error)
+	FindByOwner(ctx context.Context, req dtos.FindByOwnerRequest) ([]dtos.AttachmentResponse, error)
+	Download(ctx context.Context, req dtos.DownloadRequest) (dtos.DownloadResponse, error)
+	Remove(ctx context.Context, req dtos.RemoveRequest) error
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+)
+
+// reviewVersion is the version of every review, reviews can't be edited.
+const reviewVersion = 1
+
+// newOwnerVersion checks that user can change attachments of the owner and bumps owner version,
+// so previous version keeps its attachments snapshot. Returns new version of the owner.
+func (u Usecase) newOwnerVersion(ctx context.Context, ownerType entity.AttachmentOwnerType, ownerID, username string) (int, error) {
+	switch ownerType {
+	case entity.AttachmentTender:
+		tender, err := u.tendRepo.FindByID(ctx, ownerID)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := u.checkTenderResponsible(ctx, tender, username); err != nil {
+			return 0, err
+		}
+
+		updatedTender, err := u.tendRepo.Update(ctx, tender)
+		if err != nil {
+			return 0, err
+		}
+
+		return updatedTender.Version, nil
+	case entity.AttachmentBid:
+		bid, err := u.bidsRepo.FindByID(ctx, ownerID)
+		if err != nil {
+			return 0, err
+		}
+
+		has, err := u.bidPerms.AuthorHasPermissions(ctx, bid, username)
+		if err != nil {
+			return 0, err
+		}
+		if !has {
+			return 0, apperror.Forbidden(apperror.ErrForbidden)
+		}
+
+		updatedBid, err := u.bidsRepo.Update(ctx, bid)
+		if err != nil {
+			return 0, err
+		}
+
+		return updatedBid.Version, nil
+	case entity.AttachmentReview:
+		// Review is written by tender organization, so only it can attach files.
+		review, err := u.bidsRepo.FindReviewByID(ctx, ownerID)
+		if err != nil {
+			return 0, err
+		}
+
+		bid, err := u.bidsRepo.FindByID(ctx, review.BidID)
+		if err != nil {
+			return 0, err
+		}
+
+		tender, err := u.tendRepo.FindByID(ctx, bid.TenderID)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := u.checkTenderResponsible(ctx, tender, username); err != nil {
+			return 0, err
+		}
+
+		return reviewVersion, nil
+	default:
+		return 0, apperror.BadRequest(errors.New("owner type is invalid"))
+	}
+}
+
+// currentOwnerVersion checks that user can see the owner and returns its current version.
+func (u Usecase) currentOwnerVersion(ctx context.Context, ownerType entity.AttachmentOwnerType, ownerID, username string) (int, error) {
+	switch ownerType {
+	case entity.AttachmentTender:
+		tender, err := u.tendRepo.FindByID(ctx, ownerID)
+		if err != nil {
+			return 0, err
+		}
+
+		if tender.Status != entity.TenderPublished {
+			if err := u.checkTenderResponsible(ctx, tender, username); err != nil {
+				return 0, err
+			}
+		}
+
+		return tender.Version, nil
+	case entity.AttachmentBid:
+		bid, err := u.bidsRepo.FindByID(ctx, ownerID)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := u.checkBidVisible(ctx, bid, username); err != nil {
+			return 0, err
+		}
+
+		return bid.Version, nil
+	case entity.AttachmentReview:
+		review, err := u.bidsRepo.FindReviewByID(ctx, ownerID)
+		if err != nil {
+			return 0, err
+		}
+
+		bid, err := u.bidsRepo.FindByID(ctx, review.BidID)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := u.checkBidVisible(ctx, bid, username); err != nil {
+			return 0, err
+		}
+
+		return reviewVersion, nil
+	default:
+		return 0, apperror.BadRequest(errors.New("owner type is invalid"))
+	}
+}
+
+func (u Usecase) checkTenderResponsible(ctx context.Context, tender entity.Tender, username string) error {
+	isResponsible, err := u.orgRepo.IsOrganizationResponsible(ctx, tender.OrganizationID, username)
+	if err != nil {
+		return err
+	}
+	if !isResponsible {
+		return apperror.Forbidden(apperror.ErrForbidden)
+	}
+
+	return nil
+}
+
+// checkBidVisible checks that user is the bid author or is responsible for the tender that can see the bid.
+func (u Usecase) checkBidVisible(ctx context.Context, bid entity.Bid, username string) error {
+	has, err := u.bidPerms.AuthorHasPermissions(ctx, bid, username)
+	if err != nil && !errors.Is(err, apperror.ErrForbidden) && !errors.Is(err, apperror.ErrNotFound) {
+		return err
+	}
+	if has {
+		return nil
+	}
+
+	if bid.Status == entity.BidCreated || bid.Status == entity.BidCanceled {
+		return apperror.Forbidden(apperror.ErrForbidden)
+	}
+
+	tender, err := u.tendRepo.FindByID(ctx, bid.TenderID)
+	if err != nil {
+		return err
+	}
+
+	return u.checkTenderResponsible(ctx, tender, username)
+}
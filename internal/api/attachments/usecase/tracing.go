@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"avito-tenders/internal/api/attachments"
+	"avito-tenders/internal/api/attachments/dtos"
+	"avito-tenders/pkg/tracing"
+)
+
+// TracingUsecase wraps usecase to trace its calls.
+type TracingUsecase struct {
+	next attachments.Usecase
+}
+
+func NewTracingUsecase(next attachments.Usecase) *TracingUsecase {
+	return &TracingUsecase{next: next}
+}
+
+func (u TracingUsecase) Upload(ctx context.Context, req dtos.UploadRequest) (dtos.AttachmentResponse, error) {
+	return tracing.Do(ctx, "attachments.Upload", func(ctx context.Context) (dtos.AttachmentResponse, error) {
+		return u.next.Upload(ctx, req)
+	})
+}
+
+func (u TracingUsecase) FindByOwner(ctx context.Context, req dtos.FindByOwnerRequest) ([]dtos.AttachmentResponse, error) {
+	return tracing.Do(ctx, "attachments.FindByOwner", func(ctx context.Context) ([]dtos.AttachmentResponse, error) {
+		return u.next.FindByOwner(ctx, req)
+	})
+}
+
+func (u TracingUsecase) Download(ctx context.Context, req dtos.DownloadRequest) (dtos.DownloadResponse, error) {
+	return tracing.Do(ctx, "attachments.Download", func(ctx context.Context) (dtos.DownloadResponse, error) {
+		return u.next.Download(ctx, req)
+	})
+}
+
+func (u TracingUsecase) Remove(ctx context.Context, req dtos.RemoveRequest) error {
+	return tracing.Run(ctx, "attachments.Remove", func(ctx context.Context) error {
+		return u.next.Remove(ctx, req)
+	})
+}
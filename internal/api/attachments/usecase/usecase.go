@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	trm "github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+
+	"avito-tenders/internal/api/attachments"
+	"avito-tenders/internal/api/attachments/dtos"
+	"avito-tenders/internal/api/bids"
+	"avito-tenders/internal/api/employee"
+	"avito-tenders/internal/api/organization"
+	"avito-tenders/internal/api/tenders"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/blobstorage"
+	"avito-tenders/pkg/fwcontext"
+)
+
+// BidPermissions checks whether user can act on behalf of bid author.
+type BidPermissions interface {
+	AuthorHasPermissions(ctx context.Context, bid entity.Bid, username string) (bool, error)
+}
+
+type Usecase struct {
+	repo      attachments.Repository
+	tendRepo  tenders.Repository
+	bidsRepo  bids.Repository
+	orgRepo   organization.Repository
+	empRepo   employee.Repository
+	bidPerms  BidPermissions
+	storage   blobstorage.Storage
+	limits    blobstorage.Limits
+	trManager *trm.Manager
+}
+
+type Opts struct {
+	Repo           attachments.Repository
+	TenderRepo     tenders.Repository
+	BidsRepo       bids.Repository
+	OrgRepo        organization.Repository
+	EmpRepo        employee.Repository
+	BidPermissions BidPermissions
+	Storage        blobstorage.Storage
+	Limits         blobstorage.Limits
+	TrManager      *trm.Manager
+}
+
+func NewUsecase(opts Opts) *Usecase {
+	return &Usecase{
+		repo:      opts.Repo,
+		tendRepo:  opts.TenderRepo,
+		bidsRepo:  opts.BidsRepo,
+		orgRepo:   opts.OrgRepo,
+		empRepo:   opts.EmpRepo,
+		bidPerms:  opts.BidPermissions,
+		storage:   opts.Storage,
+		limits:    opts.Limits,
+		trManager: opts.TrManager,
+	}
+}
+
+func (u Usecase) Upload(ctx context.Context, req dtos.UploadRequest) (dtos.AttachmentResponse, error) {
+	blob, err := blobstorage.Spool(req.Content, u.limits)
+	if err != nil {
+		switch {
+		case errors.Is(err, blobstorage.ErrTooLarge):
+			return dtos.AttachmentResponse{}, apperror.RequestEntityTooLarge(err)
+		case errors.Is(err, blobstorage.ErrTypeNotAllowed), errors.Is(err, blobstorage.ErrEmpty):
+			return dtos.AttachmentResponse{}, apperror.BadRequest(err)
+		default:
+			fwcontext.GetLogger(ctx).Error("failed to spool attachment", "error", err)
+			return dtos.AttachmentResponse{}, apperror.InternalServerError(apperror.ErrInternal)
+		}
+	}
+	defer blob.Close()
+
+	var result entity.Attachment
+	err = u.trManager.Do(ctx, func(ctx context.Context) error {
+		emp, err := u.empRepo.FindByUsername(ctx, req.Username)
+		if err != nil {
+			return err
+		}
+
+		version, err := u.newOwnerVersion(ctx, req.OwnerType, req.OwnerID, req.Username)
+		if err != nil {
+			return err
+		}
+
+		// Blobs are content addressed, so blob left after failed transaction is simply reused by the next upload.
+		if err := u.storage.Put(ctx, blob.Key(), blob, blob.Size, blob.ContentType); err != nil {
+			fwcontext.GetLogger(ctx).Error("failed to put attachment to storage", "error", err)
+			return apperror.InternalServerError(apperror.ErrInternal)
+		}
+
+		result, err = u.repo.Create(ctx, entity.Attachment{
+			OwnerType:   req.OwnerType,
+			OwnerID:     req.OwnerID,
+			FileName:    req.FileName,
+			ContentType: blob.ContentType,
+			Size:        blob.Size,
+			SHA256:      blob.SHA256,
+			StorageKey:  blob.Key(),
+			UploadedBy:  emp.ID,
+			VersionFrom: version,
+		})
+
+		return err
+	})
+	if err != nil {
+		return dtos.AttachmentResponse{}, err
+	}
+
+	return dtos.NewAttachmentResponse(result), nil
+}
+
+func (u Usecase) FindByOwner(ctx context.Context, req dtos.FindByOwnerRequest) ([]dtos.AttachmentResponse, error) {
+	var result []entity.Attachment
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		version, err := u.currentOwnerVersion(ctx, req.OwnerType, req.OwnerID, req.Username)
+		if err != nil {
+			return err
+		}
+
+		if req.Version != nil {
+			if *req.Version > version {
+				return apperror.NotFound(apperror.ErrNotFound)
+			}
+
+			version = *req.Version
+		}
+
+		result, err = u.repo.FindByOwner(ctx, req.OwnerType, req.OwnerID, version)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dtos.NewAttachmentResponseList(result), nil
+}
+
+func (u Usecase) Download(ctx context.Context, req dtos.DownloadRequest) (dtos.DownloadResponse, error) {
+	attachment, err := u.repo.FindByID(ctx, req.AttachmentID)
+	if err != nil {
+		return dtos.DownloadResponse{}, err
+	}
+
+	// Attachment of any owner version can be downloaded by anyone who can see the owner.
+	if _, err := u.currentOwnerVersion(ctx, attachment.OwnerType, attachment.OwnerID, req.Username); err != nil {
+		return dtos.DownloadResponse{}, err
+	}
+
+	content, err := u.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, blobstorage.ErrNotFound) {
+			fwcontext.GetLogger(ctx).Error("attachment blob is missing", "attachment", attachment.ID, "key", attachment.StorageKey)
+			return dtos.DownloadResponse{}, apperror.NotFound(apperror.ErrNotFound)
+		}
+
+		fwcontext.GetLogger(ctx).Error("failed to get attachment from storage", "error", err)
+
+		return dtos.DownloadResponse{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return dtos.DownloadResponse{
+		Attachment: dtos.NewAttachmentResponse(attachment),
+		Content:    content,
+	}, nil
+}
+
+func (u Usecase) Remove(ctx context.Context, req dtos.RemoveRequest) error {
+	return u.trManager.Do(ctx, func(ctx context.Context) error {
+		attachment, err := u.repo.FindByID(ctx, req.AttachmentID)
+		if err != nil {
+			return err
+		}
+		if attachment.VersionTo != nil {
+			return apperror.NotFound(apperror.ErrNotFound)
+		}
+
+		version, err := u.newOwnerVersion(ctx, attachment.OwnerType, attachment.OwnerID, req.Username)
+		if err != nil {
+			return err
+		}
+
+		return u.repo.Remove(ctx, attachment.ID, version)
+	})
+}
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"avito-tenders/internal/api/bids/dtos"
+	"avito-tenders/internal/entity"
+	tendersv1 "avito-tenders/pkg/pb/tenders/v1"
+)
+
+var errBidIDEmpty = errors.New("bidID is not specified")
+
+var bidStatuses = map[tendersv1.BidStatus]entity.BidStatus{
+	tendersv1.BidStatus_BID_STATUS_CREATED:   entity.BidCreated,
+	tendersv1.BidStatus_BID_STATUS_PUBLISHED: entity.BidPublished,
+	tendersv1.BidStatus_BID_STATUS_CANCELED:  entity.BidCanceled,
+	tendersv1.BidStatus_BID_STATUS_APPROVED:  entity.BidApproved,
+	tendersv1.BidStatus_BID_STATUS_REJECTED:  entity.BidRejected,
+}
+
+var authorTypes = map[tendersv1.AuthorType]entity.AuthorType{
+	tendersv1.AuthorType_AUTHOR_TYPE_ORGANIZATION: entity.AuthorOrganization,
+	tendersv1.AuthorType_AUTHOR_TYPE_USER:         entity.AuthorUser,
+}
+
+var bidDecisions = map[tendersv1.BidDecision]entity.BidDecision{
+	tendersv1.BidDecision_BID_DECISION_APPROVED: entity.DecisionApproved,
+	tendersv1.BidDecision_BID_DECISION_REJECTED: entity.DecisionRejected,
+}
+
+// bidStatusFromProto returns empty status for unspecified and unknown values, so they fail validation.
+func bidStatusFromProto(status tendersv1.BidStatus) entity.BidStatus {
+	return bidStatuses[status]
+}
+
+func bidStatusToProto(status entity.BidStatus) tendersv1.BidStatus {
+	for protoStatus, entityStatus := range bidStatuses {
+		if entityStatus == status {
+			return protoStatus
+		}
+	}
+
+	return tendersv1.BidStatus_BID_STATUS_UNSPECIFIED
+}
+
+// authorTypeFromProto returns empty author type for unspecified and unknown values, so they fail validation.
+func authorTypeFromProto(authorType tendersv1.AuthorType) entity.AuthorType {
+	return authorTypes[authorType]
+}
+
+func authorTypeToProto(authorType entity.AuthorType) tendersv1.AuthorType {
+	for protoAuthorType, entityAuthorType := range authorTypes {
+		if entityAuthorType == authorType {
+			return protoAuthorType
+		}
+	}
+
+	return tendersv1.AuthorType_AUTHOR_TYPE_UNSPECIFIED
+}
+
+// bidDecisionFromProto returns empty decision for unspecified and unknown values, so they fail validation.
+func bidDecisionFromProto(decision tendersv1.BidDecision) entity.BidDecision {
+	return bidDecisions[decision]
+}
+
+func bidToProto(bid dtos.BidResponse) *tendersv1.Bid {
+	return &tendersv1.Bid{
+		Id:          bid.ID,
+		Name:        bid.Name,
+		Description: bid.Description,
+		Status:      bidStatusToProto(bid.Status),
+		TenderId:    bid.TenderID,
+		AuthorType:  authorTypeToProto(bid.AuthorType),
+		AuthorId:    bid.AuthorID,
+		Version:     int32(bid.Version),
+		CreatedAt:   timestamppb.New(time.Time(bid.CreatedAt)),
+		LotIds:      bid.LotIDs,
+	}
+}
+
+func bidsToProto(bidsList []dtos.BidResponse) []*tendersv1.Bid {
+	result := make([]*tendersv1.Bid, 0, len(bidsList))
+	for i := range bidsList {
+		result = append(result, bidToProto(bidsList[i]))
+	}
+
+	return result
+}
+
+func reviewsToProto(reviews []dtos.ReviewResponse) []*tendersv1.Review {
+	result := make([]*tendersv1.Review, 0, len(reviews))
+	for _, review := range reviews {
+		result = append(result, &tendersv1.Review{
+			Id:          review.ID,
+			Description: review.Description,
+			CreatedAt:   timestamppb.New(time.Time(review.CreatedAt)),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+
+	"avito-tenders/internal/api/bids"
+	"avito-tenders/internal/api/bids/dtos"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+	tendersv1 "avito-tenders/pkg/pb/tenders/v1"
+	"avito-tenders/pkg/queryparams"
+)
+
+type reviewServer struct {
+	tendersv1.UnimplementedReviewServiceServer
+
+	uc bids.Usecase
+}
+
+func (s *reviewServer) SendFeedback(ctx context.Context, req *tendersv1.SendFeedbackRequest) (*tendersv1.Bid, error) {
+	request := dtos.SendFeedbackRequest{
+		BidID:    req.GetBidId(),
+		Feedback: req.GetFeedback(),
+		Username: fwcontext.GetUsername(ctx),
+	}
+	if err := request.Validate(); err != nil {
+		return nil, apperror.BadRequest(err)
+	}
+
+	bid, err := s.uc.SendFeedback(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return bidToProto(bid), nil
+}
+
+// ListReviews returns reviews requested by the user, unlike HTTP API requester is not passed in the request.
+func (s *reviewServer) ListReviews(ctx context.Context, req *tendersv1.ListReviewsRequest) (*tendersv1.ListReviewsResponse, error) {
+	pagination, err := queryparams.NewPagination(req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	request := dtos.FindReviewsRequest{
+		TenderID:          req.GetTenderId(),
+		AuthorUsername:    req.GetAuthorUsername(),
+		RequesterUsername: fwcontext.GetUsername(ctx),
+		Pagination:        pagination,
+	}
+	if err := request.Validate(); err != nil {
+		return nil, apperror.BadRequest(err)
+	}
+
+	reviews, err := s.uc.FindReviewsByTenderID(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tendersv1.ListReviewsResponse{Reviews: reviewsToProto(reviews)}, nil
+}
@@ -0,0 +1,181 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"avito-tenders/internal/api/bids"
+	"avito-tenders/internal/api/bids/dtos"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+	tendersv1 "avito-tenders/pkg/pb/tenders/v1"
+	"avito-tenders/pkg/queryparams"
+)
+
+// PublicMethods may be called without username, like HTTP routes without UserExistsMiddleware.
+var PublicMethods = []string{
+	tendersv1.BidService_CreateBid_FullMethodName,
+}
+
+type Server struct {
+	tendersv1.UnimplementedBidServiceServer
+
+	uc bids.Usecase
+}
+
+func NewServer(uc bids.Usecase) *Server {
+	return &Server{uc: uc}
+}
+
+// RegisterBidServices registers bid service and review service, reviews are left on bids, so they share the usecase.
+func (s *Server) RegisterBidServices(r grpc.ServiceRegistrar) {
+	tendersv1.RegisterBidServiceServer(r, s)
+	tendersv1.RegisterReviewServiceServer(r, &reviewServer{uc: s.uc})
+}
+
+func (s *Server) CreateBid(ctx context.Context, req *tendersv1.CreateBidRequest) (*tendersv1.Bid, error) {
+	request := dtos.CreateBidRequest{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		TenderID:    req.GetTenderId(),
+		AuthorType:  authorTypeFromProto(req.GetAuthorType()),
+		AuthorID:    req.GetAuthorId(),
+		LotIDs:      req.GetLotIds(),
+	}
+	if err := request.Validate(); err != nil {
+		return nil, apperror.BadRequest(err)
+	}
+
+	bid, err := s.uc.Create(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return bidToProto(bid), nil
+}
+
+func (s *Server) ListMyBids(ctx context.Context, req *tendersv1.ListMyBidsRequest) (*tendersv1.ListBidsResponse, error) {
+	pagination, err := queryparams.NewPagination(req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	bidsList, err := s.uc.FindByUsername(ctx, fwcontext.GetUsername(ctx), pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tendersv1.ListBidsResponse{Bids: bidsToProto(bidsList)}, nil
+}
+
+func (s *Server) ListTenderBids(ctx context.Context, req *tendersv1.ListTenderBidsRequest) (*tendersv1.ListBidsResponse, error) {
+	pagination, err := queryparams.NewPagination(req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	request := dtos.FindByTenderIDRequest{
+		TenderID:   req.GetTenderId(),
+		Username:   fwcontext.GetUsername(ctx),
+		Pagination: pagination,
+	}
+	if err := request.Validate(); err != nil {
+		return nil, apperror.BadRequest(err)
+	}
+
+	bidsList, err := s.uc.FindByTenderID(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tendersv1.ListBidsResponse{Bids: bidsToProto(bidsList)}, nil
+}
+
+func (s *Server) GetBidStatus(ctx context.Context, req *tendersv1.GetBidStatusRequest) (*tendersv1.GetBidStatusResponse, error) {
+	if req.GetBidId() == "" {
+		return nil, apperror.BadRequest(errBidIDEmpty)
+	}
+
+	status, err := s.uc.GetStatusByID(ctx, req.GetBidId(), fwcontext.GetUsername(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tendersv1.GetBidStatusResponse{Status: bidStatusToProto(status)}, nil
+}
+
+func (s *Server) UpdateBidStatus(ctx context.Context, req *tendersv1.UpdateBidStatusRequest) (*tendersv1.Bid, error) {
+	request := dtos.UpdateStatusRequest{
+		BidID:    req.GetBidId(),
+		Status:   bidStatusFromProto(req.GetStatus()),
+		Username: fwcontext.GetUsername(ctx),
+	}
+	if err := request.Validate(); err != nil {
+		return nil, apperror.BadRequest(err)
+	}
+
+	bid, err := s.uc.UpdateStatusByID(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return bidToProto(bid), nil
+}
+
+func (s *Server) EditBid(ctx context.Context, req *tendersv1.EditBidRequest) (*tendersv1.Bid, error) {
+	request := dtos.EditBidRequest{
+		BidID:    req.GetBidId(),
+		Username: fwcontext.GetUsername(ctx),
+		EditBidBody: dtos.EditBidBody{
+			Name:        req.GetName(),
+			Description: req.GetDescription(),
+		},
+	}
+	if err := request.Validate(); err != nil {
+		return nil, apperror.BadRequest(err)
+	}
+
+	bid, err := s.uc.Edit(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return bidToProto(bid), nil
+}
+
+func (s *Server) RollbackBid(ctx context.Context, req *tendersv1.RollbackBidRequest) (*tendersv1.Bid, error) {
+	request := dtos.RollbackRequest{
+		BidID:    req.GetBidId(),
+		Version:  int(req.GetVersion()),
+		Username: fwcontext.GetUsername(ctx),
+	}
+	if err := request.Validate(); err != nil {
+		return nil, apperror.BadRequest(err)
+	}
+
+	bid, err := s.uc.Rollback(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return bidToProto(bid), nil
+}
+
+func (s *Server) SubmitDecision(ctx context.Context, req *tendersv1.SubmitDecisionRequest) (*tendersv1.Bid, error) {
+	request := dtos.SubmitDecisionRequest{
+		BidID:    req.GetBidId(),
+		Decision: bidDecisionFromProto(req.GetDecision()),
+		Username: fwcontext.GetUsername(ctx),
+	}
+	if err := request.Validate(); err != nil {
+		return nil, apperror.BadRequest(err)
+	}
+
+	bid, err := s.uc.SubmitDecision(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return bidToProto(bid), nil
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
@@ -120,7 +121,7 @@ func (h *Handlers) GetBidStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
 
 	if _, err = w.Write([]byte(status)); err != nil {
@@ -228,6 +229,10 @@ func (h *Handlers) SubmitDecision(w http.ResponseWriter, r *http.Request) {
 		Decision: entity.BidDecision(decision),
 		Username: username,
 	}
+	if r.URL.Query().Has("comment") {
+		comment := r.URL.Query().Get("comment")
+		req.Comment = &comment
+	}
 
 	if err := req.Validate(); err != nil {
 		apperror.SendError(w, apperror.BadRequest(err))
@@ -247,6 +252,126 @@ func (h *Handlers) SubmitDecision(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (h *Handlers) RetractDecision(w http.ResponseWriter, r *http.Request) {
+	request := dtos.RetractDecisionRequest{
+		BidID:    chi.URLParam(r, bidIDPathParam),
+		Username: fwcontext.GetUsername(r.Context()),
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	bid, err := h.uc.RetractDecision(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(bid); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) GetVoteTally(w http.ResponseWriter, r *http.Request) {
+	request := dtos.VoteTallyRequest{
+		BidID:    chi.URLParam(r, bidIDPathParam),
+		Username: fwcontext.GetUsername(r.Context()),
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	tally, err := h.uc.GetVoteTally(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(tally); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) DeclineAward(w http.ResponseWriter, r *http.Request) {
+	request := dtos.DeclineAwardRequest{
+		BidID:    chi.URLParam(r, bidIDPathParam),
+		Username: fwcontext.GetUsername(r.Context()),
+	}
+	if r.URL.Query().Has("reason") {
+		reason := r.URL.Query().Get("reason")
+		request.Reason = &reason
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	award, err := h.uc.DeclineAward(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(award); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) CancelAward(w http.ResponseWriter, r *http.Request) {
+	request := dtos.CancelAwardRequest{
+		BidID:    chi.URLParam(r, bidIDPathParam),
+		Reason:   r.URL.Query().Get("reason"),
+		Username: fwcontext.GetUsername(r.Context()),
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	award, err := h.uc.CancelAward(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(award); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) AcceptAward(w http.ResponseWriter, r *http.Request) {
+	request := dtos.AcceptAwardRequest{
+		BidID:    chi.URLParam(r, bidIDPathParam),
+		Username: fwcontext.GetUsername(r.Context()),
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	award, err := h.uc.AcceptAward(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(award); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
 func (h *Handlers) Rollback(w http.ResponseWriter, r *http.Request) {
 	bidID := chi.URLParam(r, bidIDPathParam)
 	if bidID == "" {
@@ -301,10 +426,34 @@ func (h *Handlers) SendFeedback(w http.ResponseWriter, r *http.Request) {
 	username := fwcontext.GetUsername(r.Context())
 	feedback := r.URL.Query().Get("bidFeedback")
 
+	var ratings dtos.FeedbackRatings
+	for _, param := range []struct {
+		name   string
+		rating **int
+	}{
+		{"rating", &ratings.Rating},
+		{"qualityRating", &ratings.Quality},
+		{"timelinessRating", &ratings.Timeliness},
+		{"communicationRating", &ratings.Communication},
+	} {
+		value := r.URL.Query().Get(param.name)
+		if value == "" {
+			continue
+		}
+
+		intValue, err := strconv.Atoi(value)
+		if err != nil {
+			apperror.SendError(w, apperror.BadRequest(fmt.Errorf("%s must be an integer", param.name)))
+			return
+		}
+		*param.rating = &intValue
+	}
+
 	request := dtos.SendFeedbackRequest{
-		BidID:    bidID,
-		Feedback: feedback,
-		Username: username,
+		BidID:           bidID,
+		Feedback:        feedback,
+		Username:        username,
+		FeedbackRatings: ratings,
 	}
 	if err := request.Validate(); err != nil {
 		apperror.SendError(w, apperror.BadRequest(err))
@@ -359,3 +508,126 @@ func (h *Handlers) FindReviewsByTender(w http.ResponseWriter, r *http.Request) {
 		apperror.SendError(w, apperror.InternalServerError(err))
 	}
 }
+
+func (h *Handlers) GetReviewsSummary(w http.ResponseWriter, r *http.Request) {
+	request := dtos.ReviewsSummaryRequest{
+		TenderID:          chi.URLParam(r, tenderIDPathParam),
+		AuthorUsername:    r.URL.Query().Get("authorUsername"),
+		RequesterUsername: r.URL.Query().Get("requesterUsername"),
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	summary, err := h.uc.GetReviewsSummary(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(summary); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) ReplyToReview(w http.ResponseWriter, r *http.Request) {
+	request := dtos.ReplyToReviewRequest{
+		ReviewID: chi.URLParam(r, reviewIDPathParam),
+		Reply:    r.URL.Query().Get("reply"),
+		Username: fwcontext.GetUsername(r.Context()),
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	review, err := h.uc.ReplyToReview(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) DisputeReview(w http.ResponseWriter, r *http.Request) {
+	request := dtos.DisputeReviewRequest{
+		ReviewID: chi.URLParam(r, reviewIDPathParam),
+		Reason:   r.URL.Query().Get("reason"),
+		Username: fwcontext.GetUsername(r.Context()),
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	review, err := h.uc.DisputeReview(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) ArbitrateReview(w http.ResponseWriter, r *http.Request) {
+	request := dtos.ArbitrateReviewRequest{
+		ReviewID: chi.URLParam(r, reviewIDPathParam),
+		Decision: entity.DisputeStatus(r.URL.Query().Get("decision")),
+		Username: fwcontext.GetUsername(r.Context()),
+	}
+	if r.URL.Query().Has("comment") {
+		comment := r.URL.Query().Get("comment")
+		request.Comment = &comment
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	review, err := h.uc.ArbitrateReview(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) GetReviewHistory(w http.ResponseWriter, r *http.Request) {
+	request := dtos.ReviewHistoryRequest{
+		ReviewID: chi.URLParam(r, reviewIDPathParam),
+		Username: fwcontext.GetUsername(r.Context()),
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	history, err := h.uc.FindReviewHistory(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(history); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
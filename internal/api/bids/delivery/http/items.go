@@ -0,0 +1,115 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"avito-tenders/internal/api/bids/dtos"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+func (h *Handlers) SetBidItems(w http.ResponseWriter, r *http.Request) {
+	bidID := chi.URLParam(r, bidIDPathParam)
+	if bidID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("bidID is not specified")))
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+		return
+	}
+
+	var quotes []dtos.BidItemQuote
+	if err := json.Unmarshal(body, &quotes); err != nil {
+		apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+		return
+	}
+
+	req := dtos.SetBidItemsRequest{
+		BidID:    bidID,
+		Username: fwcontext.GetUsername(r.Context()),
+		Items:    quotes,
+	}
+	if err := req.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	items, err := h.uc.SetItems(r.Context(), req)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(items); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) GetBidItems(w http.ResponseWriter, r *http.Request) {
+	bidID := chi.URLParam(r, bidIDPathParam)
+	if bidID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("bidID is not specified")))
+		return
+	}
+
+	req := dtos.FindBidItemsRequest{
+		BidID:    bidID,
+		Username: fwcontext.GetUsername(r.Context()),
+	}
+	if err := req.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	items, err := h.uc.FindItems(r.Context(), req)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(items); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) CompareBidItems(w http.ResponseWriter, r *http.Request) {
+	tenderID := chi.URLParam(r, tenderIDPathParam)
+	if tenderID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("tender id is not specified")))
+		return
+	}
+
+	req := dtos.CompareItemsRequest{
+		TenderID:   tenderID,
+		Username:   fwcontext.GetUsername(r.Context()),
+		Pagination: fwcontext.GetPagination(r.Context()),
+	}
+	if err := req.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	comparison, err := h.uc.CompareItems(r.Context(), req)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(comparison); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
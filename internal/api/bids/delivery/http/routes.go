@@ -12,6 +12,7 @@ const (
 	tenderIDPathParam = "tenderId"
 	bidIDPathParam    = "bidId"
 	versionPathParam  = "version"
+	reviewIDPathParam = "reviewId"
 )
 
 func (h *Handlers) MapBidsRoutes(r chi.Router, mw *middlewares.Manager) {
@@ -25,8 +26,22 @@ func (h *Handlers) MapBidsRoutes(r chi.Router, mw *middlewares.Manager) {
 		r.Put(fmt.Sprintf("/{%s}/rollback/{%s}", bidIDPathParam, versionPathParam), middlewares.Conveyor(h.Rollback, mw.UserExistsMiddleware))
 
 		r.Put(fmt.Sprintf("/{%s}/submit_decision", bidIDPathParam), middlewares.Conveyor(h.SubmitDecision, mw.UserExistsMiddleware))
+		r.Put(fmt.Sprintf("/{%s}/retract_decision", bidIDPathParam), middlewares.Conveyor(h.RetractDecision, mw.UserExistsMiddleware))
+		r.Get(fmt.Sprintf("/{%s}/votes", bidIDPathParam), middlewares.Conveyor(h.GetVoteTally, mw.UserExistsMiddleware))
+		r.Put(fmt.Sprintf("/{%s}/decline_award", bidIDPathParam), middlewares.Conveyor(h.DeclineAward, mw.UserExistsMiddleware))
+		r.Put(fmt.Sprintf("/{%s}/cancel_award", bidIDPathParam), middlewares.Conveyor(h.CancelAward, mw.UserExistsMiddleware))
+		r.Put(fmt.Sprintf("/{%s}/accept_award", bidIDPathParam), middlewares.Conveyor(h.AcceptAward, mw.UserExistsMiddleware))
 
 		r.Put(fmt.Sprintf("/{%s}/feedback", bidIDPathParam), middlewares.Conveyor(h.SendFeedback, mw.UserExistsMiddleware))
 		r.Get(fmt.Sprintf("/{%s}/reviews", tenderIDPathParam), middlewares.Conveyor(h.FindReviewsByTender, mw.PaginationMiddleware))
+		r.Get(fmt.Sprintf("/{%s}/reviews/summary", tenderIDPathParam), h.GetReviewsSummary)
+		r.Put(fmt.Sprintf("/reviews/{%s}/reply", reviewIDPathParam), middlewares.Conveyor(h.ReplyToReview, mw.UserExistsMiddleware))
+		r.Put(fmt.Sprintf("/reviews/{%s}/dispute", reviewIDPathParam), middlewares.Conveyor(h.DisputeReview, mw.UserExistsMiddleware))
+		r.Put(fmt.Sprintf("/reviews/{%s}/arbitrate", reviewIDPathParam), middlewares.Conveyor(h.ArbitrateReview, mw.UserExistsMiddleware))
+		r.Get(fmt.Sprintf("/reviews/{%s}/history", reviewIDPathParam), middlewares.Conveyor(h.GetReviewHistory, mw.UserExistsMiddleware))
+
+		r.Get(fmt.Sprintf("/{%s}/items", bidIDPathParam), middlewares.Conveyor(h.GetBidItems, mw.UserExistsMiddleware))
+		r.Put(fmt.Sprintf("/{%s}/items", bidIDPathParam), middlewares.Conveyor(h.SetBidItems, mw.UserExistsMiddleware))
+		r.Get(fmt.Sprintf("/{%s}/comparison", tenderIDPathParam), middlewares.Conveyor(h.CompareBidItems, mw.UserExistsMiddleware, mw.PaginationMiddleware))
 	})
 }
@@ -0,0 +1,52 @@
+package dtos
+
+import (
+	"github.com/invopop/validation"
+
+	"avito-tenders/internal/entity"
+)
+
+type DeclineAwardRequest struct {
+	BidID    string  `json:"bidId"`
+	Reason   *string `json:"reason"`
+	Username string  `json:"username"`
+}
+
+func (r DeclineAwardRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.BidID, validation.Required),
+		validation.Field(&r.Reason, validation.NilOrNotEmpty, validation.Length(1, 1000)),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type CancelAwardRequest struct {
+	BidID    string `json:"bidId"`
+	Reason   string `json:"reason"`
+	Username string `json:"username"`
+}
+
+func (r CancelAwardRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.BidID, validation.Required),
+		validation.Field(&r.Reason, validation.Required, validation.Length(1, 1000)),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type AcceptAwardRequest struct {
+	BidID    string `json:"bidId"`
+	Username string `json:"username"`
+}
+
+func (r AcceptAwardRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.BidID, validation.Required),
+		validation.Field(&r.Username, validation.Required))
+}
+
+// AwardResponse is the bid after the award action, the resulting tender status and the runner-up bid offered
+// the award, if any.
+type AwardResponse struct {
+	Bid          BidResponse         `json:"bid"`
+	TenderStatus entity.TenderStatus `json:"tenderStatus"`
+	OfferedBid   *BidResponse        `json:"offeredBid,omitempty"`
+}
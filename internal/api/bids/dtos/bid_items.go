@@ -0,0 +1,200 @@
+package dtos
+
+import (
+	"errors"
+
+	"github.com/invopop/validation"
+	"github.com/shopspring/decimal"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/queryparams"
+)
+
+type BidItemQuote struct {
+	ItemID    string          `json:"itemId"`
+	UnitPrice decimal.Decimal `json:"unitPrice"`
+}
+
+func (q BidItemQuote) Validate() error {
+	return validation.ValidateStruct(&q,
+		validation.Field(&q.ItemID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&q.UnitPrice, validation.By(func(value interface{}) error {
+			price, _ := value.(decimal.Decimal)
+			if price.IsNegative() {
+				return errors.New("must not be negative")
+			}
+
+			return nil
+		})))
+}
+
+type SetBidItemsRequest struct {
+	BidID    string         `json:"bidId"`
+	Username string         `json:"username"`
+	Items    []BidItemQuote `json:"items"`
+}
+
+func (r SetBidItemsRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.BidID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required),
+		validation.Field(&r.Items, validation.Required))
+}
+
+type FindBidItemsRequest struct {
+	BidID    string `json:"bidId"`
+	Username string `json:"username"`
+}
+
+func (r FindBidItemsRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.BidID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type BidItemResponse struct {
+	ItemID      string          `json:"itemId"`
+	Description string          `json:"description"`
+	Unit        string          `json:"unit"`
+	Quantity    decimal.Decimal `json:"quantity"`
+	UnitPrice   decimal.Decimal `json:"unitPrice"`
+	Total       decimal.Decimal `json:"total"`
+	Version     int             `json:"version"`
+}
+
+type BidItemsResponse struct {
+	BidID string            `json:"bidId"`
+	Items []BidItemResponse `json:"items"`
+	Total decimal.Decimal   `json:"total"`
+}
+
+// NewBidItemsResponse lays out bid quotes in the order of tender items and computes totals.
+func NewBidItemsResponse(bidID string, tenderItems []entity.TenderItem, bidItems []entity.BidItem) BidItemsResponse {
+	quotes := make(map[string]entity.BidItem, len(bidItems))
+	for _, item := range bidItems {
+		quotes[item.TenderItemID] = item
+	}
+
+	response := BidItemsResponse{
+		BidID: bidID,
+		Items: make([]BidItemResponse, 0, len(bidItems)),
+		Total: decimal.Zero,
+	}
+	for _, tenderItem := range tenderItems {
+		quote, ok := quotes[tenderItem.ID]
+		if !ok {
+			continue
+		}
+
+		total := tenderItem.Quantity.Mul(quote.UnitPrice)
+		response.Items = append(response.Items, BidItemResponse{
+			ItemID:      tenderItem.ID,
+			Description: tenderItem.Description,
+			Unit:        tenderItem.Unit,
+			Quantity:    tenderItem.Quantity,
+			UnitPrice:   quote.UnitPrice,
+			Total:       total,
+			Version:     quote.Version,
+		})
+		response.Total = response.Total.Add(total)
+	}
+
+	return response
+}
+
+type CompareItemsRequest struct {
+	TenderID string `json:"tenderId"`
+	Username string `json:"username"`
+	queryparams.Pagination
+}
+
+func (r CompareItemsRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.TenderID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type ComparisonBid struct {
+	BidID      string            `json:"bidId"`
+	Name       string            `json:"name"`
+	Status     entity.BidStatus  `json:"status"`
+	AuthorType entity.AuthorType `json:"authorType"`
+	AuthorID   string            `json:"authorId"`
+	Total      decimal.Decimal   `json:"total"`
+}
+
+type ComparisonQuote struct {
+	BidID     string          `json:"bidId"`
+	UnitPrice decimal.Decimal `json:"unitPrice"`
+	Total     decimal.Decimal `json:"total"`
+}
+
+type ComparisonItem struct {
+	ItemID      string            `json:"itemId"`
+	Description string            `json:"description"`
+	Unit        string            `json:"unit"`
+	Quantity    decimal.Decimal   `json:"quantity"`
+	Quotes      []ComparisonQuote `json:"quotes"`
+}
+
+type ItemsComparisonResponse struct {
+	TenderID string           `json:"tenderId"`
+	Items    []ComparisonItem `json:"items"`
+	Bids     []ComparisonBid  `json:"bids"`
+}
+
+// NewItemsComparisonResponse lays out quotes of all bids item by item.
+func NewItemsComparisonResponse(tenderID string, tenderItems []entity.TenderItem, bids []entity.Bid, bidItems []entity.BidItem) ItemsComparisonResponse {
+	quotes := make(map[string]map[string]decimal.Decimal, len(tenderItems))
+	for _, item := range bidItems {
+		if quotes[item.TenderItemID] == nil {
+			quotes[item.TenderItemID] = make(map[string]decimal.Decimal)
+		}
+		quotes[item.TenderItemID][item.BidID] = item.UnitPrice
+	}
+
+	totals := make(map[string]decimal.Decimal, len(bids))
+	response := ItemsComparisonResponse{
+		TenderID: tenderID,
+		Items:    make([]ComparisonItem, 0, len(tenderItems)),
+		Bids:     make([]ComparisonBid, 0, len(bids)),
+	}
+	for _, tenderItem := range tenderItems {
+		comparisonItem := ComparisonItem{
+			ItemID:      tenderItem.ID,
+			Description: tenderItem.Description,
+			Unit:        tenderItem.Unit,
+			Quantity:    tenderItem.Quantity,
+			Quotes:      make([]ComparisonQuote, 0, len(bids)),
+		}
+		for _, bid := range bids {
+			price, ok := quotes[tenderItem.ID][bid.ID]
+			if !ok {
+				continue
+			}
+
+			total := tenderItem.Quantity.Mul(price)
+			comparisonItem.Quotes = append(comparisonItem.Quotes, ComparisonQuote{
+				BidID:     bid.ID,
+				UnitPrice: price,
+				Total:     total,
+			})
+			totals[bid.ID] = totals[bid.ID].Add(total)
+		}
+
+		response.Items = append(response.Items, comparisonItem)
+	}
+
+	for _, bid := range bids {
+		response.Bids = append(response.Bids, ComparisonBid{
+			BidID:      bid.ID,
+			Name:       bid.Name,
+			Status:     bid.Status,
+			AuthorType: bid.AuthorType,
+			AuthorID:   bid.AuthorID,
+			Total:      totals[bid.ID],
+		})
+	}
+
+	return response
+}
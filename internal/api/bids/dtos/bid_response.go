@@ -1,33 +1,40 @@
 package dtos
 
 import (
+	supplierDtos "avito-tenders/internal/api/suppliers/dtos"
 	"avito-tenders/internal/entity"
 	"avito-tenders/pkg/types"
 )
 
 type BidResponse struct {
-	ID          string            `json:"id"`
-	Name        string            `json:"name"`
-	Description string            `json:"description"`
-	Status      entity.BidStatus  `json:"status"`
-	TenderID    string            `json:"tenderId"`
-	AuthorType  entity.AuthorType `json:"authorType"`
-	AuthorID    string            `json:"authorId"`
-	Version     int               `json:"version"`
-	CreatedAt   types.RFC3339Time `json:"createdAt"`
+	ID             string            `json:"id"`
+	Name           string            `json:"name"`
+	Description    string            `json:"description"`
+	Status         entity.BidStatus  `json:"status"`
+	TenderID       string            `json:"tenderId"`
+	AuthorType     entity.AuthorType `json:"authorType"`
+	AuthorID       string            `json:"authorId"`
+	OrganizationID *string           `json:"organizationId,omitempty"`
+	Version        int               `json:"version"`
+	CreatedAt      types.RFC3339Time `json:"createdAt"`
+	LotIDs         []string          `json:"lotIds,omitempty"`
+	// Reputation of the bid supplier, it is returned in lists and after feedback only.
+	Reputation *supplierDtos.ReputationResponse `json:"reputation,omitempty"`
 }
 
 func NewBidResponse(bid entity.Bid) BidResponse {
 	return BidResponse{
-		ID:          bid.ID,
-		Name:        bid.Name,
-		Description: bid.Description,
-		Status:      bid.Status,
-		TenderID:    bid.TenderID,
-		AuthorType:  bid.AuthorType,
-		AuthorID:    bid.AuthorID,
-		Version:     bid.Version,
-		CreatedAt:   types.RFCFromTime(bid.CreatedAt),
+		ID:             bid.ID,
+		Name:           bid.Name,
+		Description:    bid.Description,
+		Status:         bid.Status,
+		TenderID:       bid.TenderID,
+		AuthorType:     bid.AuthorType,
+		AuthorID:       bid.AuthorID,
+		OrganizationID: bid.OrganizationID,
+		Version:        bid.Version,
+		CreatedAt:      types.RFCFromTime(bid.CreatedAt),
+		LotIDs:         bid.LotIDs,
 	}
 }
 
@@ -7,20 +7,24 @@ import (
 )
 
 type CreateBidRequest struct {
-	Name        string            `json:"name"`
-	Description string            `json:"description"`
-	TenderID    string            `json:"tenderId"`
-	AuthorType  entity.AuthorType `json:"authorType"`
-	AuthorID    string            `json:"authorId"`
+	Name           string            `json:"name"`
+	Description    string            `json:"description"`
+	TenderID       string            `json:"tenderId"`
+	AuthorType     entity.AuthorType `json:"authorType"`
+	AuthorID       string            `json:"authorId"`
+	OrganizationID *string           `json:"organizationId,omitempty"`
+	LotIDs         []string          `json:"lotIds,omitempty"`
 }
 
 func (r CreateBidRequest) ToEntity() entity.Bid {
 	return entity.Bid{
-		Name:        r.Name,
-		Description: r.Description,
-		TenderID:    r.TenderID,
-		AuthorType:  r.AuthorType,
-		AuthorID:    r.AuthorID,
+		Name:           r.Name,
+		Description:    r.Description,
+		TenderID:       r.TenderID,
+		AuthorType:     r.AuthorType,
+		AuthorID:       r.AuthorID,
+		OrganizationID: r.OrganizationID,
+		LotIDs:         r.LotIDs,
 	}
 }
 
@@ -31,5 +35,9 @@ func (r CreateBidRequest) Validate() error {
 		validation.Field(&r.TenderID, validation.Required, validation.Length(1, 100)),
 		validation.Field(&r.AuthorType, validation.Required, r.AuthorType.ValidationRule()),
 		validation.Field(&r.AuthorID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.OrganizationID,
+			validation.When(r.AuthorType == entity.AuthorUser, validation.Nil),
+			validation.NilOrNotEmpty, validation.Length(1, 100)),
+		validation.Field(&r.LotIDs, validation.Each(validation.Required, validation.Length(1, 100))),
 	)
 }
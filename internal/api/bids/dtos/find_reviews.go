@@ -19,3 +19,16 @@ func (r FindReviewsRequest) Validate() error {
 		validation.Field(&r.AuthorUsername, validation.Required),
 		validation.Field(&r.RequesterUsername, validation.Required))
 }
+
+type ReviewsSummaryRequest struct {
+	TenderID          string `json:"tenderId"`
+	AuthorUsername    string `json:"authorUsername"`
+	RequesterUsername string `json:"requesterUsername"`
+}
+
+func (r ReviewsSummaryRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.TenderID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.AuthorUsername, validation.Required),
+		validation.Field(&r.RequesterUsername, validation.Required))
+}
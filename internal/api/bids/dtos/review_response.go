@@ -9,14 +9,31 @@ type ReviewResponse struct {
 	ID          string            `json:"id"`
 	Description string            `json:"description"`
 	CreatedAt   types.RFC3339Time `json:"createdAt"`
+	FeedbackRatings
+	Reply         *string               `json:"reply,omitempty"`
+	RepliedAt     *types.RFC3339Time    `json:"repliedAt,omitempty"`
+	DisputeStatus *entity.DisputeStatus `json:"disputeStatus,omitempty"`
+	DisputeReason *string               `json:"disputeReason,omitempty"`
+	Version       int                   `json:"version"`
 }
 
 func NewReviewResponse(review entity.Review) ReviewResponse {
-	return ReviewResponse{
-		ID:          review.ID,
-		Description: review.Description,
-		CreatedAt:   types.RFCFromTime(review.CreatedAt),
+	response := ReviewResponse{
+		ID:              review.ID,
+		Description:     review.Description,
+		CreatedAt:       types.RFCFromTime(review.CreatedAt),
+		FeedbackRatings: FeedbackRatings(review.ReviewRatings),
+		Reply:           review.Reply,
+		DisputeStatus:   review.DisputeStatus,
+		DisputeReason:   review.DisputeReason,
+		Version:         review.Version,
 	}
+	if review.RepliedAt != nil {
+		repliedAt := types.RFCFromTime(*review.RepliedAt)
+		response.RepliedAt = &repliedAt
+	}
+
+	return response
 }
 
 func NewReviewResponseList(reviews []entity.Review) []ReviewResponse {
@@ -28,3 +45,30 @@ func NewReviewResponseList(reviews []entity.Review) []ReviewResponse {
 
 	return reviewResponseList
 }
+
+type ReviewsSummaryResponse struct {
+	AuthorUsername string             `json:"authorUsername"`
+	BidsCount      int                `json:"bidsCount"`
+	TendersCount   int                `json:"tendersCount"`
+	ApprovedBids   int                `json:"approvedBids"`
+	RejectedBids   int                `json:"rejectedBids"`
+	ReviewsCount   int                `json:"reviewsCount"`
+	LastReviewAt   *types.RFC3339Time `json:"lastReviewAt,omitempty"`
+}
+
+func NewReviewsSummaryResponse(authorUsername string, summary entity.ReviewsSummary) ReviewsSummaryResponse {
+	response := ReviewsSummaryResponse{
+		AuthorUsername: authorUsername,
+		BidsCount:      summary.BidsCount,
+		TendersCount:   summary.TendersCount,
+		ApprovedBids:   summary.ApprovedBids,
+		RejectedBids:   summary.RejectedBids,
+		ReviewsCount:   summary.ReviewsCount,
+	}
+	if summary.LastReviewAt != nil {
+		lastReviewAt := types.RFCFromTime(*summary.LastReviewAt)
+		response.LastReviewAt = &lastReviewAt
+	}
+
+	return response
+}
@@ -0,0 +1,84 @@
+package dtos
+
+import (
+	"github.com/invopop/validation"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/types"
+)
+
+type ReplyToReviewRequest struct {
+	ReviewID string `json:"reviewId"`
+	Reply    string `json:"reply"`
+	Username string `json:"username"`
+}
+
+func (r ReplyToReviewRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.ReviewID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Reply, validation.Required, validation.Length(1, 1000)),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type DisputeReviewRequest struct {
+	ReviewID string `json:"reviewId"`
+	Reason   string `json:"reason"`
+	Username string `json:"username"`
+}
+
+func (r DisputeReviewRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.ReviewID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Reason, validation.Required, validation.Length(1, 1000)),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type ArbitrateReviewRequest struct {
+	ReviewID string               `json:"reviewId"`
+	Decision entity.DisputeStatus `json:"decision"`
+	Comment  *string              `json:"comment"`
+	Username string               `json:"username"`
+}
+
+func (r ArbitrateReviewRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.ReviewID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Decision, validation.Required, r.Decision.DecisionRule()),
+		validation.Field(&r.Comment, validation.NilOrNotEmpty, validation.Length(1, 1000)),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type ReviewHistoryRequest struct {
+	ReviewID string `json:"reviewId"`
+	Username string `json:"username"`
+}
+
+func (r ReviewHistoryRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.ReviewID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type ReviewAuditResponse struct {
+	Action        entity.ReviewAction `json:"action"`
+	ActorUsername string              `json:"actorUsername"`
+	Version       int                 `json:"version"`
+	Comment       *string             `json:"comment,omitempty"`
+	CreatedAt     types.RFC3339Time   `json:"createdAt"`
+}
+
+func NewReviewAuditResponseList(entries []entity.ReviewAuditEntry) []ReviewAuditResponse {
+	responses := make([]ReviewAuditResponse, 0, len(entries))
+
+	for _, entry := range entries {
+		responses = append(responses, ReviewAuditResponse{
+			Action:        entry.Action,
+			ActorUsername: entry.ActorUsername,
+			Version:       entry.Version,
+			Comment:       entry.Comment,
+			CreatedAt:     types.RFCFromTime(entry.CreatedAt),
+		})
+	}
+
+	return responses
+}
@@ -6,11 +6,24 @@ type SendFeedbackRequest struct {
 	BidID    string `json:"bidId"`
 	Feedback string `json:"bidFeedback"`
 	Username string `json:"username"`
+	FeedbackRatings
 }
 
 func (r SendFeedbackRequest) Validate() error {
 	return validation.ValidateStruct(&r,
 		validation.Field(&r.BidID, validation.Required, validation.Length(1, 100)),
 		validation.Field(&r.Feedback, validation.Required, validation.Length(1, 1000)),
-		validation.Field(&r.Username, validation.Required))
+		validation.Field(&r.Username, validation.Required),
+		validation.Field(&r.Rating, validation.NilOrNotEmpty, validation.Min(1), validation.Max(5)),
+		validation.Field(&r.Quality, validation.NilOrNotEmpty, validation.Min(1), validation.Max(5)),
+		validation.Field(&r.Timeliness, validation.NilOrNotEmpty, validation.Min(1), validation.Max(5)),
+		validation.Field(&r.Communication, validation.NilOrNotEmpty, validation.Min(1), validation.Max(5)))
+}
+
+// FeedbackRatings are optional marks from 1 to 5 of the bid supplier.
+type FeedbackRatings struct {
+	Rating        *int `json:"rating,omitempty"`
+	Quality       *int `json:"qualityRating,omitempty"`
+	Timeliness    *int `json:"timelinessRating,omitempty"`
+	Communication *int `json:"communicationRating,omitempty"`
 }
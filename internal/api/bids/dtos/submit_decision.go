@@ -4,11 +4,13 @@ import (
 	"github.com/invopop/validation"
 
 	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/types"
 )
 
 type SubmitDecisionRequest struct {
 	BidID    string             `json:"bidId"`
 	Decision entity.BidDecision `json:"decision"`
+	Comment  *string            `json:"comment"`
 	Username string             `json:"username"`
 }
 
@@ -16,5 +18,71 @@ func (r SubmitDecisionRequest) Validate() error {
 	return validation.ValidateStruct(&r,
 		validation.Field(&r.BidID, validation.Required),
 		validation.Field(&r.Decision, validation.Required, r.Decision.ValidationRule()),
+		validation.Field(&r.Comment, validation.NilOrNotEmpty, validation.Length(1, 1000)),
 		validation.Field(&r.Username, validation.Required))
 }
+
+type RetractDecisionRequest struct {
+	BidID    string `json:"bidId"`
+	Username string `json:"username"`
+}
+
+func (r RetractDecisionRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.BidID, validation.Required),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type VoteTallyRequest struct {
+	BidID    string `json:"bidId"`
+	Username string `json:"username"`
+}
+
+func (r VoteTallyRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.BidID, validation.Required),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type VoteResponse struct {
+	Username  string             `json:"username"`
+	Decision  entity.BidDecision `json:"decision"`
+	Comment   *string            `json:"comment,omitempty"`
+	CreatedAt types.RFC3339Time  `json:"createdAt"`
+	UpdatedAt types.RFC3339Time  `json:"updatedAt"`
+}
+
+type VoteTallyResponse struct {
+	BidID     string           `json:"bidId"`
+	Status    entity.BidStatus `json:"status"`
+	Quorum    int              `json:"quorum"`
+	Approved  int              `json:"approved"`
+	Rejected  int              `json:"rejected"`
+	Abstained int              `json:"abstained"`
+	Votes     []VoteResponse   `json:"votes"`
+}
+
+func NewVoteTallyResponse(bid entity.Bid, quorum int, votes []entity.BidVote) VoteTallyResponse {
+	tally := entity.TallyVotes(votes)
+
+	response := VoteTallyResponse{
+		BidID:     bid.ID,
+		Status:    bid.Status,
+		Quorum:    quorum,
+		Approved:  tally.Approved,
+		Rejected:  tally.Rejected,
+		Abstained: tally.Abstained,
+		Votes:     make([]VoteResponse, 0, len(votes)),
+	}
+	for _, vote := range votes {
+		response.Votes = append(response.Votes, VoteResponse{
+			Username:  vote.Username,
+			Decision:  vote.Decision,
+			Comment:   vote.Comment,
+			CreatedAt: types.RFCFromTime(vote.CreatedAt),
+			UpdatedAt: types.RFCFromTime(vote.UpdatedAt),
+		})
+	}
+
+	return response
+}
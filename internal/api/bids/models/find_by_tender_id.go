@@ -1,10 +1,20 @@
 package models
 
 import (
+	"avito-tenders/internal/entity"
 	"avito-tenders/pkg/queryparams"
 )
 
 type FindByTenderID struct {
 	TenderID string
+	// Statuses limits found bids to the given statuses. All bids are returned if empty.
+	Statuses []entity.BidStatus
+	queryparams.Pagination
+}
+
+type FindVisibleByTenderID struct {
+	TenderID string
+	// Username is the requester, only bids they are allowed to see are found.
+	Username string
 	queryparams.Pagination
 }
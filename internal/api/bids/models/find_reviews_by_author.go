@@ -0,0 +1,8 @@
+package models
+
+import "avito-tenders/pkg/queryparams"
+
+type FindReviewsByAuthor struct {
+	AuthorID string
+	queryparams.Pagination
+}
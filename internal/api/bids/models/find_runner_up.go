@@ -0,0 +1,8 @@
+package models
+
+type FindRunnerUp struct {
+	TenderID      string
+	ExcludedBidID string
+	// LotIDs are lots released by the withdrawn award, they are empty for tenders without lots.
+	LotIDs []string
+}
@@ -1,6 +1,11 @@
 package models
 
+import "avito-tenders/internal/entity"
+
 type SendFeedback struct {
 	BidID    string
 	Feedback string
+	entity.ReviewRatings
+	// Automatic feedback is left on rejection of competing bids.
+	Automatic bool
 }
@@ -1,11 +0,0 @@
-package models
-
-import (
-	"avito-tenders/internal/entity"
-	"avito-tenders/pkg/queryparams"
-)
-
-type FindReview struct {
-	Bids []entity.Bid
-	queryparams.Pagination
-}
@@ -12,11 +12,31 @@ type Repository interface {
 	FindByUsername(ctx context.Context, req models.FindByUsername) ([]entity.Bid, error)
 	FindByID(ctx context.Context, id string) (entity.Bid, error)
 	FindByTenderID(ctx context.Context, req models.FindByTenderID) ([]entity.Bid, error)
+	FindVisibleByTenderID(ctx context.Context, req models.FindVisibleByTenderID) ([]entity.Bid, error)
 	Update(ctx context.Context, bid entity.Bid) (entity.Bid, error)
 	FindByIDFromHistory(ctx context.Context, id string, version int) (entity.Bid, error)
-	SendFeedback(ctx context.Context, req models.SendFeedback) error
-	FindReviews(ctx context.Context, req models.FindReview) ([]entity.Review, error)
-	SubmitApproveDecision(ctx context.Context, bidID, userID string) error
-	GetBidApproveAmount(ctx context.Context, bidID string) (int, error)
-	FindBidsByOrganization(ctx context.Context, organizationID string) ([]entity.Bid, error)
+	SendFeedback(ctx context.Context, req models.SendFeedback) (entity.Review, error)
+	FindReviewsByAuthor(ctx context.Context, req models.FindReviewsByAuthor) ([]entity.Review, error)
+	GetReviewsSummary(ctx context.Context, authorID string) (entity.ReviewsSummary, error)
+	FindReviewByID(ctx context.Context, id string) (entity.Review, error)
+	ReplyToReview(ctx context.Context, id, reply string) (entity.Review, error)
+	SetReviewDispute(ctx context.Context, id string, status entity.DisputeStatus, reason *string) (entity.Review, error)
+	AddReviewAuditEntry(ctx context.Context, entry entity.ReviewAuditEntry) error
+	FindReviewAudit(ctx context.Context, reviewID string) ([]entity.ReviewAuditEntry, error)
+	SubmitVote(ctx context.Context, vote entity.BidVote) error
+	RetractVote(ctx context.Context, bidID, userID string) error
+	FindVotes(ctx context.Context, bidID string) ([]entity.BidVote, error)
+	FindRunnerUp(ctx context.Context, req models.FindRunnerUp) (entity.Bid, bool, error)
+	AddAwardWithdrawal(ctx context.Context, withdrawal entity.AwardWithdrawal) error
+	FindAwardOffer(ctx context.Context, bidID string) (entity.AwardWithdrawal, error)
+	RejectCompetingBids(ctx context.Context, tenderID, winnerID string) ([]entity.Bid, error)
+	SetBidLots(ctx context.Context, bidID string, version int, lotIDs []string) error
+	RestoreBidLots(ctx context.Context, bidID string, oldVersion, newVersion int) error
+	SetBidItems(ctx context.Context, bidID string, items []entity.BidItem) ([]entity.BidItem, error)
+	FindBidItems(ctx context.Context, bidID string) ([]entity.BidItem, error)
+	FindItemsByBidIDs(ctx context.Context, bidIDs []string) ([]entity.BidItem, error)
+	FindByIDs(ctx context.Context, ids []string) ([]entity.Bid, error)
+	FindByTenderIDs(ctx context.Context, tenderIDs []string) ([]entity.Bid, error)
+	FindHistoryByIDs(ctx context.Context, ids []string) ([]entity.Bid, error)
+	FindReviewsByBidIDs(ctx context.Context, bidIDs []string) ([]entity.Review, error)
 }
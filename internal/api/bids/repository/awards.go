@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+
+	"avito-tenders/internal/api/bids/models"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+// FindRunnerUp finds the published bid of the tender with the most approvals, the earliest one of equal bids.
+// For tenders with lots the bid must target at least one of the lots. It reports false if there is no such bid.
+func (r Repository) FindRunnerUp(ctx context.Context, req models.FindRunnerUp) (entity.Bid, bool, error) {
+	var bid entity.Bid
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &bid, `
+		select b.id, b.name, b.description, b.status, b.tender_id, b.author_type, b.author_id, b.organization_id,
+		       b.version, b.created_at,
+		       array(select bl.lot_id::text from bids_lots bl
+		             where bl.bid_id = b.id and bl.version_to is null) as lot_ids
+		from bids b
+		where b.tender_id = $1 and b.status = 'Published' and b.id <> $2
+		  and (cardinality($3::uuid[]) = 0 or exists(
+		      select 1 from bids_lots bl
+		      where bl.bid_id = b.id and bl.version_to is null and bl.lot_id = any($3::uuid[])))
+		order by (select count(*) from bids_approvals ba where ba.bid_id = b.id and ba.decision = 'Approved') desc,
+		         b.created_at, b.id
+		limit 1
+`, req.TenderID, req.ExcludedBidID, pq.Array(req.LotIDs))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Bid{}, false, nil
+		}
+
+		fwcontext.GetLogger(ctx).Error("couldn't find runner-up bid", "error", err)
+
+		return entity.Bid{}, false, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return bid, true, nil
+}
+
+// RejectCompetingBids rejects published bids of the tender that can no longer win: bids without open lots among
+// targeted ones. Versions of the bids are increased, so history keeps them published.
+func (r Repository) RejectCompetingBids(ctx context.Context, tenderID, winnerID string) ([]entity.Bid, error) {
+	bidsList := make([]entity.Bid, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &bidsList, `
+		update bids b set status = 'Rejected', version = b.version + 1
+		where b.tender_id = $1 and b.id <> $2 and b.status = 'Published'
+		  and not exists(select 1
+		                 from bids_lots bl
+		                 join tender_lots l on l.id = bl.lot_id
+		                 where bl.bid_id = b.id and bl.version_to is null and l.status = 'Open')
+		returning b.id, b.name, b.description, b.status, b.tender_id, b.author_type, b.author_id, b.organization_id,
+		          b.version, b.created_at,
+		          array(select bl.lot_id::text from bids_lots bl
+		                where bl.bid_id = b.id and bl.version_to is null) as lot_ids
+`, tenderID, winnerID)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't reject competing bids", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return bidsList, nil
+}
+
+func (r Repository) AddAwardWithdrawal(ctx context.Context, withdrawal entity.AwardWithdrawal) error {
+	_, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, `
+		insert into bids_award_withdrawals(bid_id, tender_id, action, reason, actor_id, offered_bid_id, tender_version)
+		values ($1, $2, $3, $4, $5, $6, $7)
+`, withdrawal.BidID, withdrawal.TenderID, withdrawal.Action, withdrawal.Reason, withdrawal.ActorID, withdrawal.OfferedBidID,
+		withdrawal.TenderVersion)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't add award withdrawal", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return nil
+}
+
+// FindAwardOffer finds the latest withdrawal that offered the award to the bid.
+func (r Repository) FindAwardOffer(ctx context.Context, bidID string) (entity.AwardWithdrawal, error) {
+	var withdrawal entity.AwardWithdrawal
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &withdrawal, `
+		select id, bid_id, tender_id, action, reason, actor_id, offered_bid_id, tender_version, created_at
+		from bids_award_withdrawals
+		where offered_bid_id = $1
+		order by created_at desc
+		limit 1
+`, bidID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.AwardWithdrawal{}, apperror.NotFound(apperror.ErrNotFound)
+		}
+
+		fwcontext.GetLogger(ctx).Error("couldn't find award offer", "error", err)
+
+		return entity.AwardWithdrawal{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return withdrawal, nil
+}
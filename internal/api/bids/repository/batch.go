@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+func (r Repository) FindByIDs(ctx context.Context, ids []string) ([]entity.Bid, error) {
+	bidsList := make([]entity.Bid, 0, len(ids))
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &bidsList, `
+		select b.id, b.name, b.description, b.status, b.tender_id, b.author_type, b.author_id, b.organization_id,
+		       b.version, b.created_at,
+		       array(select bl.lot_id::text from bids_lots bl
+		             where bl.bid_id = b.id and bl.version_to is null) as lot_ids
+		from bids b
+		where b.id = any($1::uuid[])
+`, pq.Array(ids))
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find bids by ids", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return bidsList, nil
+}
+
+func (r Repository) FindByTenderIDs(ctx context.Context, tenderIDs []string) ([]entity.Bid, error) {
+	bidsList := make([]entity.Bid, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &bidsList, `
+		select b.id, b.name, b.description, b.status, b.tender_id, b.author_type, b.author_id, b.organization_id,
+		       b.version, b.created_at,
+		       array(select bl.lot_id::text from bids_lots bl
+		             where bl.bid_id = b.id and bl.version_to is null) as lot_ids
+		from bids b
+		where b.tender_id = any($1::uuid[])
+		order by b.name
+`, pq.Array(tenderIDs))
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find bids by tender ids", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return bidsList, nil
+}
+
+func (r Repository) FindHistoryByIDs(ctx context.Context, ids []string) ([]entity.Bid, error) {
+	bidsList := make([]entity.Bid, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &bidsList, `
+		select bid_id as id, name, description, status, tender_id, author_type, author_id, organization_id, version, created_at
+		from bids_history
+		where bid_id = any($1::uuid[])
+		order by bid_id, version
+`, pq.Array(ids))
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find bids history by ids", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return bidsList, nil
+}
+
+func (r Repository) FindReviewsByBidIDs(ctx context.Context, bidIDs []string) ([]entity.Review, error) {
+	reviewsList := make([]entity.Review, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &reviewsList, `
+		select `+reviewColumns+` from bids_reviews
+		where bid_id = any($1::uuid[]) and `+visibleReview+`
+		order by created_at
+`, pq.Array(bidIDs))
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find bid reviews by bid ids", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return reviewsList, nil
+}
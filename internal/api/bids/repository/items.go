@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+func (r Repository) SetBidItems(ctx context.Context, bidID string, items []entity.BidItem) ([]entity.BidItem, error) {
+	tr := r.getter.DefaultTrOrDB(ctx, r.db)
+
+	result := make([]entity.BidItem, 0, len(items))
+	for _, item := range items {
+		row := tr.QueryRowxContext(ctx, `
+		insert into bid_items(bid_id, tender_item_id, unit_price)
+		values ($1, $2, $3)
+		on conflict (bid_id, tender_item_id) do update set
+		                                              unit_price = excluded.unit_price,
+		                                              version = bid_items.version + 1
+		returning id, bid_id, tender_item_id, unit_price, version, created_at
+`, bidID, item.TenderItemID, item.UnitPrice)
+		if row.Err() != nil {
+			fwcontext.GetLogger(ctx).Error("couldn't upsert bid item", "error", row.Err())
+			return nil, apperror.BadRequest(apperror.ErrInvalidInput)
+		}
+
+		var savedItem entity.BidItem
+		if err := row.StructScan(&savedItem); err != nil {
+			fwcontext.GetLogger(ctx).Error("couldn't scan bid item", "error", err)
+			return nil, apperror.InternalServerError(apperror.ErrInternal)
+		}
+
+		result = append(result, savedItem)
+	}
+
+	return result, nil
+}
+
+func (r Repository) FindBidItems(ctx context.Context, bidID string) ([]entity.BidItem, error) {
+	itemList := make([]entity.BidItem, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &itemList, `
+		select id, bid_id, tender_item_id, unit_price, version, created_at from bid_items
+		where bid_id = $1
+`, bidID)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find bid items", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return itemList, nil
+}
+
+func (r Repository) FindItemsByBidIDs(ctx context.Context, bidIDs []string) ([]entity.BidItem, error) {
+	itemList := make([]entity.BidItem, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &itemList, `
+		select id, bid_id, tender_item_id, unit_price, version, created_at from bid_items
+		where bid_id = any($1::uuid[])
+`, pq.Array(bidIDs))
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find bid items by bid ids", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return itemList, nil
+}
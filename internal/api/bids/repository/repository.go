@@ -4,7 +4,6 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"log/slog"
 
 	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/jmoiron/sqlx"
@@ -13,6 +12,8 @@ import (
 	"avito-tenders/internal/api/bids/models"
 	"avito-tenders/internal/entity"
 	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+	"avito-tenders/pkg/postgres"
 )
 
 type Repository struct {
@@ -20,46 +21,19 @@ type Repository struct {
 	getter *trmsqlx.CtxGetter
 }
 
-func (r Repository) GetBidApproveAmount(ctx context.Context, bidID string) (int, error) {
-	row := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx,
-		`select count(bid_id) from bids_approvals where bid_id = $1`, bidID)
-	if err := row.Err(); err != nil {
-		return 0, apperror.BadRequest(apperror.ErrInvalidInput)
-	}
-
-	var count int
-	if err := row.Scan(&count); err != nil {
-		return 0, apperror.BadRequest(apperror.ErrInvalidInput)
-	}
-
-	return count, nil
-}
-
 func NewRepository(db *sqlx.DB, c *trmsqlx.CtxGetter) *Repository {
 	return &Repository{db: db, getter: c}
 }
 
-func (r Repository) SubmitApproveDecision(ctx context.Context, bidID, userID string) error {
-	_, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx,
-		`insert into bids_approvals (bid_id, user_id)
-				values ($1, $2) 
-				on conflict do nothing`, bidID, userID)
-	if err != nil {
-		return apperror.BadRequest(apperror.ErrInvalidInput)
-	}
-
-	return nil
-}
-
 func (r Repository) Create(ctx context.Context, bid entity.Bid) (entity.Bid, error) {
 	tr := r.getter.DefaultTrOrDB(ctx, r.db)
 
 	row := tr.QueryRowxContext(
 		ctx,
 		`
-		insert into bids(name, description, status, tender_id, author_type, author_id) 
-		VALUES ($1, $2, $3, $4, $5, $6)
-		returning id, name, description, status, tender_id, author_type, author_id, version, created_at
+		insert into bids(name, description, status, tender_id, author_type, author_id, organization_id) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		returning id, name, description, status, tender_id, author_type, author_id, organization_id, version, created_at
 `,
 		bid.Name,
 		bid.Description,
@@ -67,6 +41,7 @@ func (r Repository) Create(ctx context.Context, bid entity.Bid) (entity.Bid, err
 		bid.TenderID,
 		bid.AuthorType,
 		bid.AuthorID,
+		bid.OrganizationID,
 	)
 
 	if row.Err() != nil {
@@ -75,7 +50,7 @@ func (r Repository) Create(ctx context.Context, bid entity.Bid) (entity.Bid, err
 
 	var createdBid entity.Bid
 	if err := row.StructScan(&createdBid); err != nil {
-		slog.Error("couldn't scan created bid", "error", err)
+		fwcontext.GetLogger(ctx).Error("couldn't scan created bid", "error", err)
 		return entity.Bid{}, apperror.InternalServerError(err)
 	}
 
@@ -86,14 +61,18 @@ func (r Repository) FindByUsername(ctx context.Context, req models.FindByUsernam
 	var bidsList []entity.Bid
 
 	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &bidsList, `
-		select b.id, b.name, b.description, b.status, b.tender_id, b.author_type, b.author_id, b.version, b.created_at from bids b 
+		select b.id, b.name, b.description, b.status, b.tender_id, b.author_type, b.author_id, b.organization_id,
+		       b.version, b.created_at,
+		       array(select bl.lot_id::text from bids_lots bl
+		             where bl.bid_id = b.id and bl.version_to is null) as lot_ids
+		from bids b 
 		join employee e on e.username = $1
 		where author_id = e.id
 		order by name
 		limit $2 offset $3
 `, req.Username, req.Limit, req.Offset)
 	if err != nil {
-		slog.Error("couldn't find bids by username", "error", err)
+		fwcontext.GetLogger(ctx).Error("couldn't find bids by username", "error", err)
 		return nil, apperror.InternalServerError(apperror.ErrInternal)
 	}
 
@@ -102,8 +81,12 @@ func (r Repository) FindByUsername(ctx context.Context, req models.FindByUsernam
 
 func (r Repository) FindByID(ctx context.Context, id string) (entity.Bid, error) {
 	row := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx,
-		`select id, name, description, status, tender_id, author_type, author_id, version, created_at from bids
-				where id = $1`, id)
+		`select b.id, b.name, b.description, b.status, b.tender_id, b.author_type, b.author_id, b.organization_id,
+		       b.version, b.created_at,
+		       array(select bl.lot_id::text from bids_lots bl
+		             where bl.bid_id = b.id and bl.version_to is null) as lot_ids
+				from bids b
+				where b.id = $1`, id)
 	if row.Err() != nil {
 		return entity.Bid{}, apperror.BadRequest(apperror.ErrInvalidInput)
 	}
@@ -114,7 +97,7 @@ func (r Repository) FindByID(ctx context.Context, id string) (entity.Bid, error)
 			return entity.Bid{}, apperror.NotFound(apperror.ErrNotFound)
 		}
 
-		slog.Error("couldn't scan found bid row", "error", err)
+		fwcontext.GetLogger(ctx).Error("couldn't scan found bid row", "error", err)
 
 		return entity.Bid{}, apperror.InternalServerError(apperror.ErrInternal)
 	}
@@ -125,14 +108,57 @@ func (r Repository) FindByID(ctx context.Context, id string) (entity.Bid, error)
 func (r Repository) FindByTenderID(ctx context.Context, req models.FindByTenderID) ([]entity.Bid, error) {
 	var bidsList []entity.Bid
 
+	statuses := make([]string, 0, len(req.Statuses))
+	for _, status := range req.Statuses {
+		statuses = append(statuses, string(status))
+	}
+
 	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &bidsList, `
-		select id, name, description, status, tender_id, author_type, author_id, version, created_at from bids
-		where tender_id = $1
-		order by name
+		select b.id, b.name, b.description, b.status, b.tender_id, b.author_type, b.author_id, b.organization_id,
+		       b.version, b.created_at,
+		       array(select bl.lot_id::text from bids_lots bl
+		             where bl.bid_id = b.id and bl.version_to is null) as lot_ids
+		from bids b
+		where b.tender_id = $1 and (cardinality($4::text[]) = 0 or b.status = any($4::text[]))
+		order by b.name
 		limit $2 offset $3
-`, req.TenderID, req.Limit, req.Offset)
+`, req.TenderID, req.Limit, req.Offset, pq.Array(statuses))
 	if err != nil {
-		slog.Error("couldn't find bids by tender id", "error", err)
+		fwcontext.GetLogger(ctx).Error("couldn't find bids by tender id", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return bidsList, nil
+}
+
+// FindVisibleByTenderID finds bids of the tender the user is allowed to see: published bids if the user is responsible
+// for the tender organization and bids of the user or of their organization in any status. Bids are filtered before
+// pagination, so pages are full.
+func (r Repository) FindVisibleByTenderID(ctx context.Context, req models.FindVisibleByTenderID) ([]entity.Bid, error) {
+	bidsList := make([]entity.Bid, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &bidsList, `
+		select b.id, b.name, b.description, b.status, b.tender_id, b.author_type, b.author_id, b.organization_id,
+		       b.version, b.created_at,
+		       array(select bl.lot_id::text from bids_lots bl
+		             where bl.bid_id = b.id and bl.version_to is null) as lot_ids
+		from bids b
+		join tenders t on t.id = b.tender_id
+		join employee e on e.username = $2
+		where b.tender_id = $1 and (
+		    (b.status = 'Published' and exists(
+		        select 1 from organization_responsible r
+		        where r.organization_id = t.organization_id and r.user_id = e.id))
+		    or (b.author_type = 'User' and b.author_id = e.id)
+		    or (b.author_type = 'Organization' and exists(
+		        select 1 from organization_responsible ur
+		        where ur.organization_id = b.organization_id and ur.user_id = e.id))
+		)
+		order by b.name
+		limit $3 offset $4
+`, req.TenderID, req.Username, req.Limit, req.Offset)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find visible bids by tender id", "error", err)
 		return nil, apperror.InternalServerError(apperror.ErrInternal)
 	}
 
@@ -152,9 +178,12 @@ func (r Repository) Update(ctx context.Context, bid entity.Bid) (entity.Bid, err
 		                tender_id = $4,
 		                author_type = $5, 
 		                author_id = $6,
+		                organization_id = $7,
 		                version = version + 1
-		            where id = $7
-		returning id, name, description, status, tender_id, author_type, author_id, version, created_at
+		            where id = $8
+		returning id, name, description, status, tender_id, author_type, author_id, organization_id, version, created_at,
+		          array(select bl.lot_id::text from bids_lots bl
+		                where bl.bid_id = bids.id and bl.version_to is null) as lot_ids
 `,
 		bid.Name,
 		bid.Description,
@@ -162,6 +191,7 @@ func (r Repository) Update(ctx context.Context, bid entity.Bid) (entity.Bid, err
 		bid.TenderID,
 		bid.AuthorType,
 		bid.AuthorID,
+		bid.OrganizationID,
 		bid.ID,
 	)
 	if row.Err() != nil {
@@ -174,7 +204,7 @@ func (r Repository) Update(ctx context.Context, bid entity.Bid) (entity.Bid, err
 			return entity.Bid{}, apperror.NotFound(apperror.ErrNotFound)
 		}
 
-		slog.Error("couldn't scan updated bid", "error", err)
+		fwcontext.GetLogger(ctx).Error("couldn't scan updated bid", "error", err)
 
 		return entity.Bid{}, apperror.InternalServerError(err)
 	}
@@ -184,7 +214,7 @@ func (r Repository) Update(ctx context.Context, bid entity.Bid) (entity.Bid, err
 
 func (r Repository) FindByIDFromHistory(ctx context.Context, id string, version int) (entity.Bid, error) {
 	row := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx, `
-select bid_id as id, name, description, status, tender_id, author_type, author_id, version, created_at
+select bid_id as id, name, description, status, tender_id, author_type, author_id, organization_id, version, created_at
 		from bids_history
 		where bid_id = $1 and version = $2`, id, version)
 	if err := row.Err(); err != nil {
@@ -197,7 +227,7 @@ select bid_id as id, name, description, status, tender_id, author_type, author_i
 			return entity.Bid{}, apperror.NotFound(apperror.ErrNotFound)
 		}
 
-		slog.Error("couldn't scan found bid row from history", "error", err)
+		fwcontext.GetLogger(ctx).Error("couldn't scan found bid row from history", "error", err)
 
 		return entity.Bid{}, apperror.InternalServerError(apperror.ErrInternal)
 	}
@@ -205,49 +235,65 @@ select bid_id as id, name, description, status, tender_id, author_type, author_i
 	return foundBid, nil
 }
 
-func (r Repository) SendFeedback(ctx context.Context, req models.SendFeedback) error {
-	_, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, `
-		insert into bids_reviews(description, bid_id) VALUES ($1, $2)
-`, req.Feedback, req.BidID)
+// SetBidLots makes the bid target the lots since the version of the bid, lots of previous versions are kept.
+func (r Repository) SetBidLots(ctx context.Context, bidID string, version int, lotIDs []string) error {
+	tr := r.getter.DefaultTrOrDB(ctx, r.db)
+
+	_, err := tr.ExecContext(ctx, `
+		update bids_lots set version_to = $2
+		where bid_id = $1 and version_to is null`, bidID, version)
 	if err != nil {
-		return apperror.BadRequest(apperror.ErrInvalidInput)
+		fwcontext.GetLogger(ctx).Error("couldn't detach bid lots", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
 	}
 
-	return nil
-}
-
-func (r Repository) FindReviews(ctx context.Context, req models.FindReview) ([]entity.Review, error) {
-	var reviewsList []entity.Review
-
-	ids := make([]string, 0, len(req.Bids))
-	for _, bid := range req.Bids {
-		ids = append(ids, bid.ID)
+	if len(lotIDs) == 0 {
+		return nil
 	}
 
-	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &reviewsList, `
-		select id, description, bid_id, created_at from bids_reviews
-		where bid_id = any($1)
-		limit $2 offset $3
-`, pq.Array(ids), req.Limit, req.Offset)
+	_, err = tr.ExecContext(ctx, `
+		insert into bids_lots(bid_id, lot_id, version_from)
+		select $1, unnest($2::uuid[]), $3
+		on conflict do nothing
+`, bidID, pq.Array(lotIDs), version)
 	if err != nil {
-		slog.Error("couldn't find bid reviews by review id", "error", err)
-		return nil, apperror.InternalServerError(apperror.ErrInternal)
+		fwcontext.GetLogger(ctx).Error("couldn't attach bid lots", "error", err)
+
+		if postgres.IsConstraintViolation(err) {
+			return apperror.BadRequest(apperror.ErrInvalidInput)
+		}
+
+		return apperror.InternalServerError(apperror.ErrInternal)
 	}
 
-	return reviewsList, nil
+	return nil
 }
 
-func (r Repository) FindBidsByOrganization(ctx context.Context, organizationID string) ([]entity.Bid, error) {
-	var bidsList []entity.Bid
+// RestoreBidLots makes the new version of the bid target the lots of the old version.
+func (r Repository) RestoreBidLots(ctx context.Context, bidID string, oldVersion, newVersion int) error {
+	tr := r.getter.DefaultTrOrDB(ctx, r.db)
 
-	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &bidsList, `
-			select b.id, b.name, b.description, b.status, b.tender_id, b.author_type, b.author_id, b.version, b.created_at from bids b
-			join tenders t on t.organization_id = $1
-			where tender_id = t.id`, organizationID)
+	// Detach current lots that were targeted after the old version.
+	_, err := tr.ExecContext(ctx, `
+		update bids_lots set version_to = $3
+		where bid_id = $1 and version_to is null and version_from > $2`,
+		bidID, oldVersion, newVersion)
 	if err != nil {
-		slog.Error("couldn't find bid list by organization id", "error", err)
-		return nil, apperror.InternalServerError(apperror.ErrInternal)
+		fwcontext.GetLogger(ctx).Error("failed to detach bid lots on restore", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
 	}
 
-	return bidsList, nil
+	// Attach again lots of the old version that were detached since then.
+	_, err = tr.ExecContext(ctx, `
+		insert into bids_lots(bid_id, lot_id, version_from)
+		select bid_id, lot_id, $3
+		from bids_lots
+		where bid_id = $1 and version_from <= $2 and version_to is not null and version_to > $2`,
+		bidID, oldVersion, newVersion)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("failed to reattach bid lots on restore", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return nil
 }
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"avito-tenders/internal/api/bids/models"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+const reviewColumns = `id, description, bid_id, created_at, rating, quality_rating, timeliness_rating,
+		communication_rating, reply, replied_at, dispute_status, dispute_reason, version, automatic`
+
+// visibleReview filters out reviews that are disputed and not restored by arbitration.
+const visibleReview = `(dispute_status is null or dispute_status = 'Rejected')`
+
+func (r Repository) SendFeedback(ctx context.Context, req models.SendFeedback) (entity.Review, error) {
+	var review entity.Review
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &review, `
+		insert into bids_reviews(description, bid_id, rating, quality_rating, timeliness_rating, communication_rating,
+		                         automatic)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		returning `+reviewColumns,
+		req.Feedback, req.BidID, req.Rating, req.Quality, req.Timeliness, req.Communication, req.Automatic)
+	if err != nil {
+		return entity.Review{}, apperror.BadRequest(apperror.ErrInvalidInput)
+	}
+
+	return review, nil
+}
+
+func (r Repository) FindReviewByID(ctx context.Context, id string) (entity.Review, error) {
+	row := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx, `
+		select `+reviewColumns+` from bids_reviews
+		where id = $1`, id)
+	if row.Err() != nil {
+		return entity.Review{}, apperror.BadRequest(apperror.ErrInvalidInput)
+	}
+
+	var review entity.Review
+	if err := row.StructScan(&review); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Review{}, apperror.NotFound(apperror.ErrNotFound)
+		}
+
+		fwcontext.GetLogger(ctx).Error("couldn't scan review", "error", err)
+
+		return entity.Review{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return review, nil
+}
+
+// FindReviewsByAuthor finds reviews on bids of the author in tenders of all organizations, the latest first.
+// Disputed reviews are hidden until the dispute is rejected.
+func (r Repository) FindReviewsByAuthor(ctx context.Context, req models.FindReviewsByAuthor) ([]entity.Review, error) {
+	reviewsList := make([]entity.Review, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &reviewsList, `
+		select `+reviewColumns+` from bids_reviews
+		where bid_id in (select b.id from bids b where b.author_id = $1) and `+visibleReview+`
+		order by created_at desc, id
+		limit $2 offset $3
+`, req.AuthorID, req.Limit, req.Offset)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find reviews by author", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return reviewsList, nil
+}
+
+func (r Repository) GetReviewsSummary(ctx context.Context, authorID string) (entity.ReviewsSummary, error) {
+	var summary entity.ReviewsSummary
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &summary, `
+		select count(distinct b.id) as bids_count,
+		       count(distinct b.tender_id) as tenders_count,
+		       count(distinct b.id) filter (where b.status = 'Approved') as approved_bids,
+		       count(distinct b.id) filter (where b.status = 'Rejected') as rejected_bids,
+		       count(br.id) as reviews_count,
+		       max(br.created_at) as last_review_at
+		from bids b
+		left join bids_reviews br on br.bid_id = b.id and `+visibleReview+`
+		where b.author_id = $1
+`, authorID)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't get reviews summary", "error", err)
+		return entity.ReviewsSummary{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return summary, nil
+}
+
+// ReplyToReview sets the reply of the bid author and increases version of the review.
+func (r Repository) ReplyToReview(ctx context.Context, id, reply string) (entity.Review, error) {
+	var review entity.Review
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &review, `
+		update bids_reviews set reply = $2, replied_at = now(), version = version + 1
+		where id = $1
+		returning `+reviewColumns, id, reply)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't reply to review", "error", err)
+		return entity.Review{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return review, nil
+}
+
+// SetReviewDispute changes dispute of the review and increases its version. The reason is kept if it is nil.
+func (r Repository) SetReviewDispute(ctx context.Context, id string, status entity.DisputeStatus, reason *string) (entity.Review, error) {
+	var review entity.Review
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &review, `
+		update bids_reviews set dispute_status = $2, dispute_reason = coalesce($3, dispute_reason), version = version + 1
+		where id = $1
+		returning `+reviewColumns, id, status, reason)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't set review dispute", "error", err)
+		return entity.Review{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return review, nil
+}
+
+func (r Repository) AddReviewAuditEntry(ctx context.Context, entry entity.ReviewAuditEntry) error {
+	_, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, `
+		insert into bids_reviews_audit(review_id, action, actor_id, version, comment)
+		values ($1, $2, $3, $4, $5)
+`, entry.ReviewID, entry.Action, entry.ActorID, entry.Version, entry.Comment)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't add review audit entry", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return nil
+}
+
+// FindReviewAudit returns audit log of the review, the oldest action first.
+func (r Repository) FindReviewAudit(ctx context.Context, reviewID string) ([]entity.ReviewAuditEntry, error) {
+	entries := make([]entity.ReviewAuditEntry, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &entries, `
+		select a.id, a.review_id, a.action, a.actor_id, e.username as actor_username, a.version, a.comment, a.created_at
+		from bids_reviews_audit a
+		join employee e on e.id = a.actor_id
+		where a.review_id = $1
+		order by a.created_at, a.version
+`, reviewID)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find review audit", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+	"avito-tenders/pkg/postgres"
+)
+
+// SubmitVote saves the vote of the user, the previous vote of the user on the bid is replaced.
+func (r Repository) SubmitVote(ctx context.Context, vote entity.BidVote) error {
+	_, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, `
+		insert into bids_approvals (bid_id, user_id, decision, comment)
+		values ($1, $2, $3, $4)
+		on conflict (bid_id, user_id) do update set
+		    decision = excluded.decision,
+		    comment = excluded.comment,
+		    updated_at = now()
+`, vote.BidID, vote.UserID, vote.Decision, vote.Comment)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't submit vote", "error", err)
+
+		if postgres.IsConstraintViolation(err) {
+			return apperror.BadRequest(apperror.ErrInvalidInput)
+		}
+
+		return apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return nil
+}
+
+func (r Repository) RetractVote(ctx context.Context, bidID, userID string) error {
+	res, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx,
+		`delete from bids_approvals where bid_id = $1 and user_id = $2`, bidID, userID)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't retract vote", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't get retracted votes", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
+	}
+	if affected == 0 {
+		return apperror.NotFound(apperror.ErrNotFound)
+	}
+
+	return nil
+}
+
+// FindVotes returns votes on the bid, the earliest first.
+func (r Repository) FindVotes(ctx context.Context, bidID string) ([]entity.BidVote, error) {
+	votes := make([]entity.BidVote, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &votes, `
+		select ba.bid_id, ba.user_id, e.username, ba.decision, ba.comment, ba.created_at, ba.updated_at
+		from bids_approvals ba
+		join employee e on e.id = ba.user_id
+		where ba.bid_id = $1
+		order by ba.created_at, e.username
+`, bidID)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find votes", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return votes, nil
+}
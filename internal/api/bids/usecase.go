@@ -16,7 +16,20 @@ type Usecase interface {
 	UpdateStatusByID(ctx context.Context, req dtos.UpdateStatusRequest) (dtos.BidResponse, error)
 	Edit(ctx context.Context, req dtos.EditBidRequest) (dtos.BidResponse, error)
 	SubmitDecision(ctx context.Context, req dtos.SubmitDecisionRequest) (dtos.BidResponse, error)
+	RetractDecision(ctx context.Context, req dtos.RetractDecisionRequest) (dtos.BidResponse, error)
+	GetVoteTally(ctx context.Context, req dtos.VoteTallyRequest) (dtos.VoteTallyResponse, error)
+	DeclineAward(ctx context.Context, req dtos.DeclineAwardRequest) (dtos.AwardResponse, error)
+	CancelAward(ctx context.Context, req dtos.CancelAwardRequest) (dtos.AwardResponse, error)
+	AcceptAward(ctx context.Context, req dtos.AcceptAwardRequest) (dtos.AwardResponse, error)
 	SendFeedback(ctx context.Context, req dtos.SendFeedbackRequest) (dtos.BidResponse, error)
 	Rollback(ctx context.Context, req dtos.RollbackRequest) (dtos.BidResponse, error)
 	FindReviewsByTenderID(ctx context.Context, req dtos.FindReviewsRequest) ([]dtos.ReviewResponse, error)
+	GetReviewsSummary(ctx context.Context, req dtos.ReviewsSummaryRequest) (dtos.ReviewsSummaryResponse, error)
+	ReplyToReview(ctx context.Context, req dtos.ReplyToReviewRequest) (dtos.ReviewResponse, error)
+	DisputeReview(ctx context.Context, req dtos.DisputeReviewRequest) (dtos.ReviewResponse, error)
+	ArbitrateReview(ctx context.Context, req dtos.ArbitrateReviewRequest) (dtos.ReviewResponse, error)
+	FindReviewHistory(ctx context.Context, req dtos.ReviewHistoryRequest) ([]dtos.ReviewAuditResponse, error)
+	SetItems(ctx context.Context, req dtos.SetBidItemsRequest) (dtos.BidItemsResponse, error)
+	FindItems(ctx context.Context, req dtos.FindBidItemsRequest) (dtos.BidItemsResponse, error)
+	CompareItems(ctx context.Context, req dtos.CompareItemsRequest) (dtos.ItemsComparisonResponse, error)
 }
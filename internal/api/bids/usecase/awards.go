@@ -0,0 +1,279 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"avito-tenders/internal/api/bids/dtos"
+	"avito-tenders/internal/api/bids/models"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+)
+
+// DeclineAward withdraws the award on behalf of the bid author. The runner-up is offered the award,
+// the tender reopens otherwise.
+func (u Usecase) DeclineAward(ctx context.Context, req dtos.DeclineAwardRequest) (dtos.AwardResponse, error) {
+	var response dtos.AwardResponse
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		bid, err := u.repo.FindByID(ctx, req.BidID)
+		if err != nil {
+			return err
+		}
+
+		if _, err = u.AuthorHasPermissions(ctx, bid, req.Username); err != nil {
+			return err
+		}
+
+		response, err = u.withdrawAward(ctx, bid, entity.AwardDeclined, req.Reason, req.Username)
+
+		return err
+	})
+	if err != nil {
+		return dtos.AwardResponse{}, err
+	}
+
+	return response, nil
+}
+
+// CancelAward withdraws the award on behalf of the tender organization. The runner-up is offered the award,
+// the tender reopens otherwise.
+func (u Usecase) CancelAward(ctx context.Context, req dtos.CancelAwardRequest) (dtos.AwardResponse, error) {
+	var response dtos.AwardResponse
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		bid, err := u.repo.FindByID(ctx, req.BidID)
+		if err != nil {
+			return err
+		}
+
+		if err = u.checkTenderResponsible(ctx, bid.TenderID, req.Username); err != nil {
+			return err
+		}
+
+		response, err = u.withdrawAward(ctx, bid, entity.AwardCancelled, &req.Reason, req.Username)
+
+		return err
+	})
+	if err != nil {
+		return dtos.AwardResponse{}, err
+	}
+
+	return response, nil
+}
+
+// AcceptAward approves the bid offered the award, it is awarded the same way as the bid approved by quorum.
+func (u Usecase) AcceptAward(ctx context.Context, req dtos.AcceptAwardRequest) (dtos.AwardResponse, error) {
+	var response dtos.AwardResponse
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		bid, err := u.repo.FindByID(ctx, req.BidID)
+		if err != nil {
+			return err
+		}
+
+		if _, err = u.AuthorHasPermissions(ctx, bid, req.Username); err != nil {
+			return err
+		}
+
+		if bid.Status != entity.BidOffered {
+			return apperror.BadRequest(errors.New("bid is not offered the award"))
+		}
+
+		tender, err := u.tendRepo.FindByID(ctx, bid.TenderID)
+		if err != nil {
+			return err
+		}
+
+		if err = u.checkOfferOpen(ctx, tender, bid); err != nil {
+			return err
+		}
+
+		bid.Status = entity.BidApproved
+		updatedBid, err := u.repo.Update(ctx, bid)
+		if err != nil {
+			return err
+		}
+
+		tenderClosed, err := u.awardBid(ctx, tender, updatedBid, req.Username)
+		if err != nil {
+			return err
+		}
+		if tenderClosed {
+			tender.Status = entity.TenderClosed
+		}
+
+		response = dtos.AwardResponse{
+			Bid:          dtos.NewBidResponse(updatedBid),
+			TenderStatus: tender.Status,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return dtos.AwardResponse{}, err
+	}
+
+	return response, nil
+}
+
+// withdrawAward takes the award from the bid, releases its lots and offers them to the runner-up.
+// Tender stays closed while the offer is pending, closed tender is reopened if nobody is offered the award.
+func (u Usecase) withdrawAward(ctx context.Context, bid entity.Bid, action entity.AwardAction, reason *string, username string) (dtos.AwardResponse, error) {
+	if !bid.Status.IsAwarded() {
+		return dtos.AwardResponse{}, apperror.BadRequest(errors.New("bid is not awarded"))
+	}
+
+	actor, err := u.empRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return dtos.AwardResponse{}, err
+	}
+
+	tender, err := u.tendRepo.FindByID(ctx, bid.TenderID)
+	if err != nil {
+		return dtos.AwardResponse{}, err
+	}
+
+	bid.Status = entity.BidRevoked
+	if action == entity.AwardDeclined {
+		bid.Status = entity.BidDeclined
+	}
+
+	withdrawnBid, err := u.repo.Update(ctx, bid)
+	if err != nil {
+		return dtos.AwardResponse{}, err
+	}
+
+	offeredLotIDs, hasLots, err := u.releaseLots(ctx, tender.ID, bid)
+	if err != nil {
+		return dtos.AwardResponse{}, err
+	}
+
+	response := dtos.AwardResponse{Bid: dtos.NewBidResponse(withdrawnBid)}
+
+	var (
+		runnerUp entity.Bid
+		found    bool
+	)
+	if !hasLots || len(offeredLotIDs) != 0 {
+		runnerUp, found, err = u.repo.FindRunnerUp(ctx, models.FindRunnerUp{
+			TenderID:      tender.ID,
+			ExcludedBidID: bid.ID,
+			LotIDs:        offeredLotIDs,
+		})
+		if err != nil {
+			return dtos.AwardResponse{}, err
+		}
+	}
+
+	var (
+		offeredBidID  *string
+		tenderVersion *int
+	)
+	if found {
+		runnerUp.Status = entity.BidOffered
+
+		offeredBid, err := u.repo.Update(ctx, runnerUp)
+		if err != nil {
+			return dtos.AwardResponse{}, err
+		}
+
+		offeredBidResponse := dtos.NewBidResponse(offeredBid)
+		response.OfferedBid = &offeredBidResponse
+		offeredBidID = &offeredBid.ID
+		tenderVersion = &tender.Version
+	}
+
+	if !found && tender.Status == entity.TenderClosed {
+		tender.Status = entity.TenderPublished
+
+		if tender, err = u.tendRepo.Update(ctx, tender); err != nil {
+			return dtos.AwardResponse{}, err
+		}
+	}
+	response.TenderStatus = tender.Status
+
+	err = u.repo.AddAwardWithdrawal(ctx, entity.AwardWithdrawal{
+		BidID:         bid.ID,
+		TenderID:      tender.ID,
+		Action:        action,
+		Reason:        reason,
+		ActorID:       actor.ID,
+		OfferedBidID:  offeredBidID,
+		TenderVersion: tenderVersion,
+	})
+	if err != nil {
+		return dtos.AwardResponse{}, err
+	}
+
+	return response, nil
+}
+
+// checkOfferOpen checks that the award offered to the bid can still be accepted: the tender is published or kept
+// closed for the offer without changes since then, and some of the lots targeted by the bid are still open.
+func (u Usecase) checkOfferOpen(ctx context.Context, tender entity.Tender, bid entity.Bid) error {
+	switch tender.Status {
+	case entity.TenderPublished:
+	case entity.TenderClosed:
+		offer, err := u.repo.FindAwardOffer(ctx, bid.ID)
+		if err != nil {
+			return err
+		}
+		if offer.TenderVersion == nil || *offer.TenderVersion != tender.Version {
+			return apperror.BadRequest(errors.New("tender is closed"))
+		}
+	default:
+		return apperror.BadRequest(errors.New("tender is not published"))
+	}
+
+	if len(bid.LotIDs) == 0 {
+		return nil
+	}
+
+	lots, err := u.tendRepo.FindLotsByTenderID(ctx, tender.ID)
+	if err != nil {
+		return err
+	}
+
+	targeted := make(map[string]struct{}, len(bid.LotIDs))
+	for _, lotID := range bid.LotIDs {
+		targeted[lotID] = struct{}{}
+	}
+
+	for _, lot := range lots {
+		if _, ok := targeted[lot.ID]; ok && lot.Status == entity.LotOpen {
+			return nil
+		}
+	}
+
+	return apperror.BadRequest(errors.New("targeted lots are not open"))
+}
+
+// releaseLots reopens lots awarded to the bid. It returns open lots targeted by the bid, they are offered
+// to the runner-up, and reports whether the tender has lots at all.
+func (u Usecase) releaseLots(ctx context.Context, tenderID string, bid entity.Bid) ([]string, bool, error) {
+	lots, err := u.tendRepo.FindLotsByTenderID(ctx, tenderID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	targeted := make(map[string]struct{}, len(bid.LotIDs))
+	for _, lotID := range bid.LotIDs {
+		targeted[lotID] = struct{}{}
+	}
+
+	offered := make([]string, 0, len(bid.LotIDs))
+	for _, lot := range lots {
+		if lot.Status == entity.LotAwarded && lot.AwardedBidID != nil && *lot.AwardedBidID == bid.ID {
+			lot.Status = entity.LotOpen
+			lot.AwardedBidID = nil
+
+			if _, err := u.tendRepo.UpdateLot(ctx, lot); err != nil {
+				return nil, false, err
+			}
+		}
+
+		if _, ok := targeted[lot.ID]; ok && lot.Status == entity.LotOpen {
+			offered = append(offered, lot.ID)
+		}
+	}
+
+	return offered, len(lots) != 0, nil
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/fwcontext"
+	"avito-tenders/pkg/metrics"
+)
+
+// findConflictOfInterest returns the reason the author can't bid on the tender, if any. The author conflicts with
+// the tender organization when they are its responsible, or when they or one of their organizations are declared
+// as its related party.
+func (u Usecase) findConflictOfInterest(ctx context.Context, tender entity.Tender, authorID string) (entity.ConflictReason, bool, error) {
+	responsibilities, err := u.orgRepo.FindUserResponsibilities(ctx, []string{authorID})
+	if err != nil {
+		return "", false, err
+	}
+
+	organizationIDs := make([]string, 0, len(responsibilities))
+	for _, responsibility := range responsibilities {
+		if responsibility.OrganizationID == tender.OrganizationID {
+			return entity.ConflictOwnOrganization, true, nil
+		}
+
+		organizationIDs = append(organizationIDs, responsibility.OrganizationID)
+	}
+
+	related, err := u.orgRepo.IsRelatedParty(ctx, tender.OrganizationID, authorID, organizationIDs)
+	if err != nil {
+		return "", false, err
+	}
+	if related {
+		return entity.ConflictRelatedParty, true, nil
+	}
+
+	return "", false, nil
+}
+
+// logConflictAttempt records the refused bid, so the tender organization can review attempted violations.
+func (u Usecase) logConflictAttempt(ctx context.Context, attempt entity.ConflictAttempt) error {
+	metrics.BidConflicts.WithLabelValues(string(attempt.Reason)).Inc()
+
+	fwcontext.GetLogger(ctx).Warn("bid refused because of conflict of interest",
+		"tender_id", attempt.TenderID, "author_id", attempt.AuthorID, "reason", attempt.Reason)
+
+	return u.orgRepo.AddConflictAttempt(ctx, attempt)
+}
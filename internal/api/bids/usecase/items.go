@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"avito-tenders/internal/api/bids/dtos"
+	"avito-tenders/internal/api/bids/models"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+)
+
+// SetItems saves unit prices of the bid. Bid must quote every line item of the tender exactly once.
+func (u Usecase) SetItems(ctx context.Context, req dtos.SetBidItemsRequest) (dtos.BidItemsResponse, error) {
+	var result dtos.BidItemsResponse
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		bid, err := u.repo.FindByID(ctx, req.BidID)
+		if err != nil {
+			return err
+		}
+
+		has, err := u.AuthorHasPermissions(ctx, bid, req.Username)
+		if err != nil {
+			return err
+		}
+		if !has {
+			return apperror.Forbidden(apperror.ErrForbidden)
+		}
+
+		if bid.Status != entity.BidCreated && bid.Status != entity.BidPublished {
+			return apperror.Forbidden(apperror.ErrForbidden)
+		}
+
+		tenderItems, err := u.tendRepo.FindItemsByTenderID(ctx, bid.TenderID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkBidItems(tenderItems, req.Items); err != nil {
+			return err
+		}
+
+		bidItems := make([]entity.BidItem, 0, len(req.Items))
+		for _, quote := range req.Items {
+			bidItems = append(bidItems, entity.BidItem{
+				BidID:        bid.ID,
+				TenderItemID: quote.ItemID,
+				UnitPrice:    quote.UnitPrice,
+			})
+		}
+
+		savedItems, err := u.repo.SetBidItems(ctx, bid.ID, bidItems)
+		if err != nil {
+			return err
+		}
+
+		result = dtos.NewBidItemsResponse(bid.ID, tenderItems, savedItems)
+
+		return nil
+	})
+	if err != nil {
+		return dtos.BidItemsResponse{}, err
+	}
+
+	return result, nil
+}
+
+// FindItems returns bid quotes to bid author or to responsible for tender.
+func (u Usecase) FindItems(ctx context.Context, req dtos.FindBidItemsRequest) (dtos.BidItemsResponse, error) {
+	var result dtos.BidItemsResponse
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		bid, err := u.repo.FindByID(ctx, req.BidID)
+		if err != nil {
+			return err
+		}
+
+		tender, err := u.tendRepo.FindByID(ctx, bid.TenderID)
+		if err != nil {
+			return err
+		}
+
+		isResponsible, err := u.orgRepo.IsOrganizationResponsible(ctx, tender.OrganizationID, req.Username)
+		if err != nil {
+			return err
+		}
+		if !isResponsible || bid.Status == entity.BidCreated || bid.Status == entity.BidCanceled {
+			has, err := u.AuthorHasPermissions(ctx, bid, req.Username)
+			if err != nil {
+				return err
+			}
+			if !has {
+				return apperror.Forbidden(apperror.ErrForbidden)
+			}
+		}
+
+		tenderItems, err := u.tendRepo.FindItemsByTenderID(ctx, bid.TenderID)
+		if err != nil {
+			return err
+		}
+
+		bidItems, err := u.repo.FindBidItems(ctx, bid.ID)
+		if err != nil {
+			return err
+		}
+
+		result = dtos.NewBidItemsResponse(bid.ID, tenderItems, bidItems)
+
+		return nil
+	})
+	if err != nil {
+		return dtos.BidItemsResponse{}, err
+	}
+
+	return result, nil
+}
+
+// CompareItems lays out submitted bids of the tender item by item for responsible for tender.
+func (u Usecase) CompareItems(ctx context.Context, req dtos.CompareItemsRequest) (dtos.ItemsComparisonResponse, error) {
+	var result dtos.ItemsComparisonResponse
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		tender, err := u.tendRepo.FindByID(ctx, req.TenderID)
+		if err != nil {
+			return err
+		}
+
+		isResponsible, err := u.orgRepo.IsOrganizationResponsible(ctx, tender.OrganizationID, req.Username)
+		if err != nil {
+			return err
+		}
+		if !isResponsible {
+			return apperror.Forbidden(apperror.ErrForbidden)
+		}
+
+		tenderItems, err := u.tendRepo.FindItemsByTenderID(ctx, tender.ID)
+		if err != nil {
+			return err
+		}
+
+		bidsList, err := u.repo.FindByTenderID(ctx, models.FindByTenderID{
+			TenderID:   tender.ID,
+			Statuses:   []entity.BidStatus{entity.BidPublished, entity.BidApproved, entity.BidRejected},
+			Pagination: req.Pagination,
+		})
+		if err != nil {
+			return err
+		}
+
+		bidIDs := make([]string, 0, len(bidsList))
+		for _, bid := range bidsList {
+			bidIDs = append(bidIDs, bid.ID)
+		}
+
+		bidItems, err := u.repo.FindItemsByBidIDs(ctx, bidIDs)
+		if err != nil {
+			return err
+		}
+
+		result = dtos.NewItemsComparisonResponse(tender.ID, tenderItems, bidsList, bidItems)
+
+		return nil
+	})
+	if err != nil {
+		return dtos.ItemsComparisonResponse{}, err
+	}
+
+	return result, nil
+}
+
+// checkBidItems checks that quotes match tender items one to one.
+func checkBidItems(tenderItems []entity.TenderItem, quotes []dtos.BidItemQuote) error {
+	if len(tenderItems) == 0 {
+		return apperror.BadRequest(errors.New("tender has no items"))
+	}
+
+	expected := make(map[string]struct{}, len(tenderItems))
+	for _, item := range tenderItems {
+		expected[item.ID] = struct{}{}
+	}
+
+	quoted := make(map[string]struct{}, len(quotes))
+	for _, quote := range quotes {
+		if _, ok := expected[quote.ItemID]; !ok {
+			return apperror.BadRequest(errors.New("item does not belong to tender"))
+		}
+		if _, ok := quoted[quote.ItemID]; ok {
+			return apperror.BadRequest(errors.New("item is quoted more than once"))
+		}
+
+		quoted[quote.ItemID] = struct{}{}
+	}
+
+	if len(quoted) != len(expected) {
+		return apperror.BadRequest(errors.New("all tender items must be quoted"))
+	}
+
+	return nil
+}
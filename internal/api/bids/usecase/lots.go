@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"avito-tenders/internal/api/bids/models"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/metrics"
+)
+
+// checkBidLots checks that bid targets open lots of the tender.
+// Bids for tenders with lots must target at least one lot, bids for tenders without lots must target none.
+func (u Usecase) checkBidLots(ctx context.Context, tenderID string, lotIDs []string) error {
+	lots, err := u.tendRepo.FindLotsByTenderID(ctx, tenderID)
+	if err != nil {
+		return err
+	}
+
+	if len(lots) == 0 {
+		if len(lotIDs) != 0 {
+			return apperror.BadRequest(errors.New("tender has no lots"))
+		}
+
+		return nil
+	}
+
+	if len(lotIDs) == 0 {
+		return apperror.BadRequest(errors.New("lots are required for tender with lots"))
+	}
+
+	tenderLots := make(map[string]entity.TenderLot, len(lots))
+	for _, lot := range lots {
+		tenderLots[lot.ID] = lot
+	}
+
+	for _, lotID := range lotIDs {
+		lot, ok := tenderLots[lotID]
+		if !ok {
+			return apperror.NotFound(apperror.ErrNotFound)
+		}
+		if lot.Status != entity.LotOpen {
+			return apperror.Forbidden(apperror.ErrForbidden)
+		}
+	}
+
+	return nil
+}
+
+// awardBid awards open lots targeted by the approved bid and closes the tender
+// when all its lots are awarded or cancelled. Tenders without lots are closed immediately.
+// Competing bids are rejected if the tender is configured so. Returns true if tender was closed.
+func (u Usecase) awardBid(ctx context.Context, tender entity.Tender, bid entity.Bid, username string) (bool, error) {
+	lots, err := u.tendRepo.FindLotsByTenderID(ctx, tender.ID)
+	if err != nil {
+		return false, err
+	}
+
+	targeted := make(map[string]struct{}, len(bid.LotIDs))
+	for _, lotID := range bid.LotIDs {
+		targeted[lotID] = struct{}{}
+	}
+
+	settled := true
+	for _, lot := range lots {
+		if _, ok := targeted[lot.ID]; ok && lot.Status == entity.LotOpen {
+			lot.Status = entity.LotAwarded
+			lot.AwardedBidID = &bid.ID
+
+			if _, err := u.tendRepo.UpdateLot(ctx, lot); err != nil {
+				return false, err
+			}
+		}
+
+		if !lot.Status.IsFinal() {
+			settled = false
+		}
+	}
+
+	if err := u.rejectCompetingBids(ctx, tender.ID, bid.ID, username); err != nil {
+		return false, err
+	}
+
+	if !settled {
+		return false, nil
+	}
+
+	newTender := tender
+	newTender.Status = entity.TenderClosed
+	if _, err := u.tendRepo.Update(ctx, newTender); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// rejectCompetingBids rejects bids that can no longer win after the award and leaves the configured feedback
+// on them on behalf of the user.
+func (u Usecase) rejectCompetingBids(ctx context.Context, tenderID, winnerID, username string) error {
+	settings, err := u.tendRepo.FindAwardSettings(ctx, tenderID)
+	if err != nil {
+		return err
+	}
+	if !settings.RejectCompetingBids {
+		return nil
+	}
+
+	rejected, err := u.repo.RejectCompetingBids(ctx, tenderID, winnerID)
+	if err != nil {
+		return err
+	}
+	metrics.BidsRejected.Add(float64(len(rejected)))
+
+	if settings.RejectionFeedback == nil {
+		return nil
+	}
+
+	for _, bid := range rejected {
+		// Nobody has reviewed the bid, so the feedback is not counted in reputation.
+		review, err := u.repo.SendFeedback(ctx, models.SendFeedback{
+			BidID:     bid.ID,
+			Feedback:  *settings.RejectionFeedback,
+			Automatic: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err = u.auditReview(ctx, review, entity.ReviewCreated, username, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
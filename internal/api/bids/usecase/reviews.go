@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"avito-tenders/internal/api/bids/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+)
+
+// ReplyToReview saves the answer of the bid author to the review, the previous reply is replaced.
+func (u Usecase) ReplyToReview(ctx context.Context, req dtos.ReplyToReviewRequest) (dtos.ReviewResponse, error) {
+	var result entity.Review
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		review, err := u.findAuthoredReview(ctx, req.ReviewID, req.Username)
+		if err != nil {
+			return err
+		}
+
+		result, err = u.repo.ReplyToReview(ctx, review.ID, req.Reply)
+		if err != nil {
+			return err
+		}
+
+		return u.auditReview(ctx, result, entity.ReviewReplied, req.Username, &req.Reply)
+	})
+	if err != nil {
+		return dtos.ReviewResponse{}, err
+	}
+
+	return dtos.NewReviewResponse(result), nil
+}
+
+// DisputeReview hides the review until responsibles for the tender organization arbitrate the dispute.
+// The review can be disputed once.
+func (u Usecase) DisputeReview(ctx context.Context, req dtos.DisputeReviewRequest) (dtos.ReviewResponse, error) {
+	var result entity.Review
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		review, err := u.findAuthoredReview(ctx, req.ReviewID, req.Username)
+		if err != nil {
+			return err
+		}
+
+		if review.IsDisputed() {
+			return apperror.BadRequest(errors.New("review is already disputed"))
+		}
+
+		result, err = u.repo.SetReviewDispute(ctx, review.ID, entity.DisputeOpen, &req.Reason)
+		if err != nil {
+			return err
+		}
+
+		return u.auditReview(ctx, result, entity.ReviewDisputed, req.Username, &req.Reason)
+	})
+	if err != nil {
+		return dtos.ReviewResponse{}, err
+	}
+
+	return dtos.NewReviewResponse(result), nil
+}
+
+// ArbitrateReview resolves the open dispute. Accepted dispute removes the review from supplier reputation,
+// rejected one shows the review again.
+func (u Usecase) ArbitrateReview(ctx context.Context, req dtos.ArbitrateReviewRequest) (dtos.ReviewResponse, error) {
+	var result entity.Review
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		review, err := u.repo.FindReviewByID(ctx, req.ReviewID)
+		if err != nil {
+			return err
+		}
+
+		bid, err := u.repo.FindByID(ctx, review.BidID)
+		if err != nil {
+			return err
+		}
+
+		if err = u.checkTenderResponsible(ctx, bid.TenderID, req.Username); err != nil {
+			return err
+		}
+
+		if review.DisputeStatus == nil || *review.DisputeStatus != entity.DisputeOpen {
+			return apperror.BadRequest(errors.New("review has no open dispute"))
+		}
+
+		result, err = u.repo.SetReviewDispute(ctx, review.ID, req.Decision, nil)
+		if err != nil {
+			return err
+		}
+
+		if req.Decision == entity.DisputeAccepted && !review.Automatic {
+			if err = u.supplierRepo.RemoveReview(ctx, bid.ID, review.ReviewRatings); err != nil {
+				return err
+			}
+		}
+
+		return u.auditReview(ctx, result, entity.ReviewArbitrated, req.Username, req.Comment)
+	})
+	if err != nil {
+		return dtos.ReviewResponse{}, err
+	}
+
+	return dtos.NewReviewResponse(result), nil
+}
+
+// FindReviewHistory returns audit log of the review to the bid author and responsibles for the tender organization.
+func (u Usecase) FindReviewHistory(ctx context.Context, req dtos.ReviewHistoryRequest) ([]dtos.ReviewAuditResponse, error) {
+	if _, err := u.empRepo.FindByUsername(ctx, req.Username); err != nil {
+		return nil, err
+	}
+
+	review, err := u.repo.FindReviewByID(ctx, req.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := u.repo.FindByID(ctx, review.BidID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = u.checkTenderResponsible(ctx, bid.TenderID, req.Username); err != nil {
+		if _, err = u.AuthorHasPermissions(ctx, bid, req.Username); err != nil {
+			return nil, err
+		}
+	}
+
+	entries, err := u.repo.FindReviewAudit(ctx, review.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dtos.NewReviewAuditResponseList(entries), nil
+}
+
+// findAuthoredReview returns the review if the user can act on behalf of the bid author.
+func (u Usecase) findAuthoredReview(ctx context.Context, reviewID, username string) (entity.Review, error) {
+	review, err := u.repo.FindReviewByID(ctx, reviewID)
+	if err != nil {
+		return entity.Review{}, err
+	}
+
+	bid, err := u.repo.FindByID(ctx, review.BidID)
+	if err != nil {
+		return entity.Review{}, err
+	}
+
+	if _, err = u.AuthorHasPermissions(ctx, bid, username); err != nil {
+		return entity.Review{}, err
+	}
+
+	return review, nil
+}
+
+// checkTenderResponsible checks the user is responsible for the organization of the tender.
+func (u Usecase) checkTenderResponsible(ctx context.Context, tenderID, username string) error {
+	tender, err := u.tendRepo.FindByID(ctx, tenderID)
+	if err != nil {
+		return err
+	}
+
+	isResponsible, err := u.orgRepo.IsOrganizationResponsible(ctx, tender.OrganizationID, username)
+	if err != nil {
+		return err
+	}
+	if !isResponsible {
+		return apperror.Forbidden(apperror.ErrForbidden)
+	}
+
+	return nil
+}
+
+// auditReview records the action of the user that resulted in the current version of the review.
+func (u Usecase) auditReview(ctx context.Context, review entity.Review, action entity.ReviewAction, username string, comment *string) error {
+	actor, err := u.empRepo.FindByUsername(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.AddReviewAuditEntry(ctx, entity.ReviewAuditEntry{
+		ReviewID: review.ID,
+		Action:   action,
+		ActorID:  actor.ID,
+		Version:  review.Version,
+		Comment:  comment,
+	})
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+
+	"avito-tenders/internal/api/bids"
+	"avito-tenders/internal/api/bids/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/queryparams"
+	"avito-tenders/pkg/tracing"
+)
+
+// TracingUsecase wraps usecase to trace its calls.
+type TracingUsecase struct {
+	next bids.Usecase
+}
+
+func NewTracingUsecase(next bids.Usecase) *TracingUsecase {
+	return &TracingUsecase{next: next}
+}
+
+func (u TracingUsecase) Create(ctx context.Context, req dtos.CreateBidRequest) (dtos.BidResponse, error) {
+	return tracing.Do(ctx, "bids.Create", func(ctx context.Context) (dtos.BidResponse, error) {
+		return u.next.Create(ctx, req)
+	})
+}
+
+func (u TracingUsecase) FindByUsername(ctx context.Context, username string, pagination queryparams.Pagination) ([]dtos.BidResponse, error) {
+	return tracing.Do(ctx, "bids.FindByUsername", func(ctx context.Context) ([]dtos.BidResponse, error) {
+		return u.next.FindByUsername(ctx, username, pagination)
+	})
+}
+
+func (u TracingUsecase) FindByTenderID(ctx context.Context, req dtos.FindByTenderIDRequest) ([]dtos.BidResponse, error) {
+	return tracing.Do(ctx, "bids.FindByTenderID", func(ctx context.Context) ([]dtos.BidResponse, error) {
+		return u.next.FindByTenderID(ctx, req)
+	})
+}
+
+func (u TracingUsecase) GetStatusByID(ctx context.Context, bidID, username string) (entity.BidStatus, error) {
+	return tracing.Do(ctx, "bids.GetStatusByID", func(ctx context.Context) (entity.BidStatus, error) {
+		return u.next.GetStatusByID(ctx, bidID, username)
+	})
+}
+
+func (u TracingUsecase) UpdateStatusByID(ctx context.Context, req dtos.UpdateStatusRequest) (dtos.BidResponse, error) {
+	return tracing.Do(ctx, "bids.UpdateStatusByID", func(ctx context.Context) (dtos.BidResponse, error) {
+		return u.next.UpdateStatusByID(ctx, req)
+	})
+}
+
+func (u TracingUsecase) Edit(ctx context.Context, req dtos.EditBidRequest) (dtos.BidResponse, error) {
+	return tracing.Do(ctx, "bids.Edit", func(ctx context.Context) (dtos.BidResponse, error) {
+		return u.next.Edit(ctx, req)
+	})
+}
+
+func (u TracingUsecase) SubmitDecision(ctx context.Context, req dtos.SubmitDecisionRequest) (dtos.BidResponse, error) {
+	return tracing.Do(ctx, "bids.SubmitDecision", func(ctx context.Context) (dtos.BidResponse, error) {
+		return u.next.SubmitDecision(ctx, req)
+	})
+}
+
+func (u TracingUsecase) RetractDecision(ctx context.Context, req dtos.RetractDecisionRequest) (dtos.BidResponse, error) {
+	return tracing.Do(ctx, "bids.RetractDecision", func(ctx context.Context) (dtos.BidResponse, error) {
+		return u.next.RetractDecision(ctx, req)
+	})
+}
+
+func (u TracingUsecase) GetVoteTally(ctx context.Context, req dtos.VoteTallyRequest) (dtos.VoteTallyResponse, error) {
+	return tracing.Do(ctx, "bids.GetVoteTally", func(ctx context.Context) (dtos.VoteTallyResponse, error) {
+		return u.next.GetVoteTally(ctx, req)
+	})
+}
+
+func (u TracingUsecase) DeclineAward(ctx context.Context, req dtos.DeclineAwardRequest) (dtos.AwardResponse, error) {
+	return tracing.Do(ctx, "bids.DeclineAward", func(ctx context.Context) (dtos.AwardResponse, error) {
+		return u.next.DeclineAward(ctx, req)
+	})
+}
+
+func (u TracingUsecase) CancelAward(ctx context.Context, req dtos.CancelAwardRequest) (dtos.AwardResponse, error) {
+	return tracing.Do(ctx, "bids.CancelAward", func(ctx context.Context) (dtos.AwardResponse, error) {
+		return u.next.CancelAward(ctx, req)
+	})
+}
+
+func (u TracingUsecase) AcceptAward(ctx context.Context, req dtos.AcceptAwardRequest) (dtos.AwardResponse, error) {
+	return tracing.Do(ctx, "bids.AcceptAward", func(ctx context.Context) (dtos.AwardResponse, error) {
+		return u.next.AcceptAward(ctx, req)
+	})
+}
+
+func (u TracingUsecase) SendFeedback(ctx context.Context, req dtos.SendFeedbackRequest) (dtos.BidResponse, error) {
+	return tracing.Do(ctx, "bids.SendFeedback", func(ctx context.Context) (dtos.BidResponse, error) {
+		return u.next.SendFeedback(ctx, req)
+	})
+}
+
+func (u TracingUsecase) Rollback(ctx context.Context, req dtos.RollbackRequest) (dtos.BidResponse, error) {
+	return tracing.Do(ctx, "bids.Rollback", func(ctx context.Context) (dtos.BidResponse, error) {
+		return u.next.Rollback(ctx, req)
+	})
+}
+
+func (u TracingUsecase) FindReviewsByTenderID(ctx context.Context, req dtos.FindReviewsRequest) ([]dtos.ReviewResponse, error) {
+	return tracing.Do(ctx, "bids.FindReviewsByTenderID", func(ctx context.Context) ([]dtos.ReviewResponse, error) {
+		return u.next.FindReviewsByTenderID(ctx, req)
+	})
+}
+
+func (u TracingUsecase) GetReviewsSummary(ctx context.Context, req dtos.ReviewsSummaryRequest) (dtos.ReviewsSummaryResponse, error) {
+	return tracing.Do(ctx, "bids.GetReviewsSummary", func(ctx context.Context) (dtos.ReviewsSummaryResponse, error) {
+		return u.next.GetReviewsSummary(ctx, req)
+	})
+}
+
+func (u TracingUsecase) ReplyToReview(ctx context.Context, req dtos.ReplyToReviewRequest) (dtos.ReviewResponse, error) {
+	return tracing.Do(ctx, "bids.ReplyToReview", func(ctx context.Context) (dtos.ReviewResponse, error) {
+		return u.next.ReplyToReview(ctx, req)
+	})
+}
+
+func (u TracingUsecase) DisputeReview(ctx context.Context, req dtos.DisputeReviewRequest) (dtos.ReviewResponse, error) {
+	return tracing.Do(ctx, "bids.DisputeReview", func(ctx context.Context) (dtos.ReviewResponse, error) {
+		return u.next.DisputeReview(ctx, req)
+	})
+}
+
+func (u TracingUsecase) ArbitrateReview(ctx context.Context, req dtos.ArbitrateReviewRequest) (dtos.ReviewResponse, error) {
+	return tracing.Do(ctx, "bids.ArbitrateReview", func(ctx context.Context) (dtos.ReviewResponse, error) {
+		return u.next.ArbitrateReview(ctx, req)
+	})
+}
+
+func (u TracingUsecase) FindReviewHistory(ctx context.Context, req dtos.ReviewHistoryRequest) ([]dtos.ReviewAuditResponse, error) {
+	return tracing.Do(ctx, "bids.FindReviewHistory", func(ctx context.Context) ([]dtos.ReviewAuditResponse, error) {
+		return u.next.FindReviewHistory(ctx, req)
+	})
+}
+
+func (u TracingUsecase) SetItems(ctx context.Context, req dtos.SetBidItemsRequest) (dtos.BidItemsResponse, error) {
+	return tracing.Do(ctx, "bids.SetItems", func(ctx context.Context) (dtos.BidItemsResponse, error) {
+		return u.next.SetItems(ctx, req)
+	})
+}
+
+func (u TracingUsecase) FindItems(ctx context.Context, req dtos.FindBidItemsRequest) (dtos.BidItemsResponse, error) {
+	return tracing.Do(ctx, "bids.FindItems", func(ctx context.Context) (dtos.BidItemsResponse, error) {
+		return u.next.FindItems(ctx, req)
+	})
+}
+
+func (u TracingUsecase) CompareItems(ctx context.Context, req dtos.CompareItemsRequest) (dtos.ItemsComparisonResponse, error) {
+	return tracing.Do(ctx, "bids.CompareItems", func(ctx context.Context) (dtos.ItemsComparisonResponse, error) {
+		return u.next.CompareItems(ctx, req)
+	})
+}
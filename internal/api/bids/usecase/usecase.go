@@ -3,49 +3,60 @@ package usecase
 import (
 	"context"
 	"errors"
-	"log/slog"
 
 	trm "github.com/avito-tech/go-transaction-manager/trm/v2/manager"
 
+	"avito-tenders/internal/api/attachments"
 	"avito-tenders/internal/api/bids"
 	"avito-tenders/internal/api/bids/dtos"
 	"avito-tenders/internal/api/bids/models"
 	"avito-tenders/internal/api/employee"
 	"avito-tenders/internal/api/organization"
+	"avito-tenders/internal/api/suppliers"
+	supplierDtos "avito-tenders/internal/api/suppliers/dtos"
 	"avito-tenders/internal/api/tenders"
 	"avito-tenders/internal/entity"
 	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+	"avito-tenders/pkg/metrics"
 	"avito-tenders/pkg/queryparams"
 )
 
 type Usecase struct {
-	repo      bids.Repository
-	orgRepo   organization.Repository
-	empRepo   employee.Repository
-	tendRepo  tenders.Repository
-	trManager *trm.Manager
+	repo         bids.Repository
+	orgRepo      organization.Repository
+	empRepo      employee.Repository
+	tendRepo     tenders.Repository
+	attachRepo   attachments.Repository
+	supplierRepo suppliers.Repository
+	trManager    *trm.Manager
 }
 
 type Opts struct {
-	Repo       bids.Repository
-	OrgRepo    organization.Repository
-	EmpRepo    employee.Repository
-	TenderRepo tenders.Repository
-	TrManager  *trm.Manager
+	Repo         bids.Repository
+	OrgRepo      organization.Repository
+	EmpRepo      employee.Repository
+	TenderRepo   tenders.Repository
+	AttachRepo   attachments.Repository
+	SupplierRepo suppliers.Repository
+	TrManager    *trm.Manager
 }
 
 func NewUsecase(createOpts Opts) *Usecase {
 	return &Usecase{
-		repo:      createOpts.Repo,
-		trManager: createOpts.TrManager,
-		orgRepo:   createOpts.OrgRepo,
-		empRepo:   createOpts.EmpRepo,
-		tendRepo:  createOpts.TenderRepo,
+		repo:         createOpts.Repo,
+		trManager:    createOpts.TrManager,
+		orgRepo:      createOpts.OrgRepo,
+		empRepo:      createOpts.EmpRepo,
+		tendRepo:     createOpts.TenderRepo,
+		attachRepo:   createOpts.AttachRepo,
+		supplierRepo: createOpts.SupplierRepo,
 	}
 }
 
 func (u Usecase) Create(ctx context.Context, req dtos.CreateBidRequest) (dtos.BidResponse, error) {
 	var result entity.Bid
+	var conflict bool
 	err := u.trManager.Do(ctx, func(ctx context.Context) error {
 		// Check does user exist.
 		_, err := u.empRepo.FindByID(ctx, req.AuthorID)
@@ -56,14 +67,11 @@ func (u Usecase) Create(ctx context.Context, req dtos.CreateBidRequest) (dtos.Bi
 		// Check does author exist.
 		switch req.AuthorType {
 		case entity.AuthorOrganization:
-			_, err := u.orgRepo.GetUserOrganization(ctx, req.AuthorID)
+			organizationID, err := u.findActingOrganization(ctx, req.AuthorID, req.OrganizationID)
 			if err != nil {
-				if errors.Is(err, apperror.ErrNotFound) {
-					return apperror.Forbidden(apperror.ErrForbidden)
-				}
-
 				return err
 			}
+			req.OrganizationID = &organizationID
 
 		case entity.AuthorUser:
 			break
@@ -80,12 +88,40 @@ func (u Usecase) Create(ctx context.Context, req dtos.CreateBidRequest) (dtos.Bi
 			return apperror.Forbidden(apperror.ErrForbidden)
 		}
 
+		// Check conflict of interest with the tender organization.
+		reason, found, err := u.findConflictOfInterest(ctx, tender, req.AuthorID)
+		if err != nil {
+			return err
+		}
+		if found {
+			// The attempt is committed, the bid is refused after the transaction.
+			conflict = true
+
+			return u.logConflictAttempt(ctx, entity.ConflictAttempt{
+				TenderID:       tender.ID,
+				OrganizationID: tender.OrganizationID,
+				AuthorType:     req.AuthorType,
+				AuthorID:       req.AuthorID,
+				Reason:         reason,
+			})
+		}
+
+		// Check targeted lots.
+		if err := u.checkBidLots(ctx, tender.ID, req.LotIDs); err != nil {
+			return err
+		}
+
 		// Create bid.
 		createdBid, err := u.repo.Create(ctx, req.ToEntity())
 		if err != nil {
 			return err
 		}
 
+		if err := u.repo.SetBidLots(ctx, createdBid.ID, createdBid.Version, req.LotIDs); err != nil {
+			return err
+		}
+		createdBid.LotIDs = req.LotIDs
+
 		result = createdBid
 
 		return nil
@@ -93,6 +129,11 @@ func (u Usecase) Create(ctx context.Context, req dtos.CreateBidRequest) (dtos.Bi
 	if err != nil {
 		return dtos.BidResponse{}, err
 	}
+	if conflict {
+		return dtos.BidResponse{}, apperror.Forbidden(apperror.ErrConflictOfInterest)
+	}
+
+	metrics.BidsSubmitted.Inc()
 
 	return dtos.NewBidResponse(result), nil
 }
@@ -106,54 +147,59 @@ func (u Usecase) FindByUsername(ctx context.Context, username string, pagination
 		return nil, err
 	}
 
-	return dtos.NewBidResponseList(bidsList), nil
+	return u.withReputation(ctx, bidsList)
 }
 
 func (u Usecase) FindByTenderID(ctx context.Context, req dtos.FindByTenderIDRequest) ([]dtos.BidResponse, error) {
-	var filteredBidsList []dtos.BidResponse
+	var responses []dtos.BidResponse
 
 	err := u.trManager.Do(ctx, func(ctx context.Context) error {
-		bidsList, err := u.repo.FindByTenderID(ctx, models.FindByTenderID{
+		if _, err := u.tendRepo.FindByID(ctx, req.TenderID); err != nil {
+			return err
+		}
+
+		// Visibility rules are applied by the query, so the page is filtered before limit and offset.
+		bidsList, err := u.repo.FindVisibleByTenderID(ctx, models.FindVisibleByTenderID{
 			TenderID:   req.TenderID,
+			Username:   req.Username,
 			Pagination: req.Pagination,
 		})
 		if err != nil {
 			return err
 		}
 
-		tender, err := u.tendRepo.FindByID(ctx, req.TenderID)
-		if err != nil {
-			return err
-		}
+		responses, err = u.withReputation(ctx, bidsList)
 
-		isResponsible, err := u.orgRepo.IsOrganizationResponsible(ctx, tender.OrganizationID, req.Username)
-		if err != nil {
-			return err
-		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		filteredBidsList = make([]dtos.BidResponse, 0, len(bidsList))
-		for _, bid := range bidsList {
-			// If user is responsible for tender we need to add `Published` bids
-			if isResponsible && bid.Status == entity.BidPublished {
-				filteredBidsList = append(filteredBidsList, dtos.NewBidResponse(bid))
-				continue
-			}
+	return responses, nil
+}
 
-			// If user created bid or bid was created by user from his company, then we need to add this bid.
-			// Status doesn't matter
-			has, _ := u.AuthorHasPermissions(ctx, bid, req.Username)
-			if has {
-				filteredBidsList = append(filteredBidsList, dtos.NewBidResponse(bid))
-			}
-		}
+// withReputation returns responses of the bids with reputation of their suppliers.
+func (u Usecase) withReputation(ctx context.Context, bidsList []entity.Bid) ([]dtos.BidResponse, error) {
+	bidIDs := make([]string, 0, len(bidsList))
+	for _, bid := range bidsList {
+		bidIDs = append(bidIDs, bid.ID)
+	}
 
-		return nil
-	})
+	reputations, err := u.supplierRepo.FindByBidIDs(ctx, bidIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	return filteredBidsList, nil
+	responses := dtos.NewBidResponseList(bidsList)
+	for i := range responses {
+		if reputation, ok := reputations[responses[i].ID]; ok {
+			response := supplierDtos.NewReputationResponse(reputation)
+			responses[i].Reputation = &response
+		}
+	}
+
+	return responses, nil
 }
 
 func (u Usecase) GetStatusByID(ctx context.Context, bidID, username string) (entity.BidStatus, error) {
@@ -198,13 +244,20 @@ func (u Usecase) UpdateStatusByID(ctx context.Context, req dtos.UpdateStatusRequ
 	return dtos.NewBidResponse(updatedBid), nil
 }
 
+// SubmitDecision saves the vote of the responsible, the previous vote is replaced. The bid is approved or rejected
+// once quorum of responsibles votes the same way.
 func (u Usecase) SubmitDecision(ctx context.Context, req dtos.SubmitDecisionRequest) (dtos.BidResponse, error) {
-	var resultBid dtos.BidResponse
+	var (
+		resultBid      dtos.BidResponse
+		previousStatus entity.BidStatus
+		tenderClosed   bool
+	)
 	err := u.trManager.Do(ctx, func(ctx context.Context) error {
 		bid, err := u.repo.FindByID(ctx, req.BidID)
 		if err != nil {
 			return err
 		}
+		previousStatus = bid.Status
 
 		tender, err := u.tendRepo.FindByID(ctx, bid.TenderID)
 		if err != nil {
@@ -219,68 +272,31 @@ func (u Usecase) SubmitDecision(ctx context.Context, req dtos.SubmitDecisionRequ
 			return apperror.Forbidden(apperror.ErrForbidden)
 		}
 
-		if req.Decision == entity.DecisionRejected {
-			newBid := bid
-			newBid.Status = entity.BidRejected
-
-			updatedBid, err := u.repo.Update(ctx, newBid)
-			if err != nil {
-				return err
-			}
-
-			resultBid = dtos.NewBidResponse(updatedBid)
-
-			return nil
+		if bid.Status != entity.BidPublished {
+			return apperror.BadRequest(errors.New("bid is not open for voting"))
 		}
 
-		if req.Decision == entity.DecisionApproved {
-			user, err := u.empRepo.FindByUsername(ctx, req.Username)
-			if err != nil {
-				return err
-			}
-
-			err = u.repo.SubmitApproveDecision(ctx, bid.ID, user.ID)
-			if err != nil {
-				return err
-			}
-
-			approveBidCount, err := u.repo.GetBidApproveAmount(ctx, bid.ID)
-			if err != nil {
-				return err
-			}
+		user, err := u.empRepo.FindByUsername(ctx, req.Username)
+		if err != nil {
+			return err
+		}
 
-			responsibleList, err := u.orgRepo.GetOrganizationResponsible(ctx, tender.OrganizationID)
-			if err != nil {
-				return err
-			}
+		err = u.repo.SubmitVote(ctx, entity.BidVote{
+			BidID:    bid.ID,
+			UserID:   user.ID,
+			Decision: req.Decision,
+			Comment:  req.Comment,
+		})
+		if err != nil {
+			return err
+		}
 
-			if approveBidCount >= min(3, len(responsibleList)) {
-				// Update bid status
-				newBid := bid
-				newBid.Status = entity.BidApproved
-
-				updatedBid, err := u.repo.Update(ctx, newBid)
-				if err != nil {
-					return err
-				}
-				resultBid = dtos.NewBidResponse(updatedBid)
-
-				// Update tender status
-				newTender := tender
-				newTender.Status = entity.TenderClosed
-				_, err = u.tendRepo.Update(ctx, newTender)
-				if err != nil {
-					return err
-				}
-			} else {
-				bid, err = u.repo.FindByID(ctx, req.BidID)
-				if err != nil {
-					return err
-				}
-
-				resultBid = dtos.NewBidResponse(bid)
-			}
+		updatedBid, closed, err := u.applyVotes(ctx, tender, bid, req.Username)
+		if err != nil {
+			return err
 		}
+		resultBid = dtos.NewBidResponse(updatedBid)
+		tenderClosed = closed
 
 		return nil
 	})
@@ -288,6 +304,19 @@ func (u Usecase) SubmitDecision(ctx context.Context, req dtos.SubmitDecisionRequ
 		return dtos.BidResponse{}, err
 	}
 
+	metrics.ApprovalVotes.WithLabelValues(string(req.Decision)).Inc()
+	if resultBid.Status != previousStatus {
+		switch resultBid.Status {
+		case entity.BidApproved:
+			metrics.BidsApproved.Inc()
+		case entity.BidRejected:
+			metrics.BidsRejected.Inc()
+		}
+	}
+	if tenderClosed {
+		metrics.TendersClosed.Inc()
+	}
+
 	return resultBid, nil
 }
 
@@ -302,7 +331,7 @@ func (u Usecase) SendFeedback(ctx context.Context, req dtos.SendFeedbackRequest)
 
 		tender, err := u.tendRepo.FindByID(ctx, bid.TenderID)
 		if err != nil {
-			slog.Error("couldn't find tender by bid id")
+			fwcontext.GetLogger(ctx).Error("couldn't find tender by bid id")
 			return err
 		}
 
@@ -314,111 +343,173 @@ func (u Usecase) SendFeedback(ctx context.Context, req dtos.SendFeedbackRequest)
 			return apperror.Forbidden(apperror.ErrForbidden)
 		}
 
-		err = u.repo.SendFeedback(ctx, models.SendFeedback{
-			BidID:    req.BidID,
-			Feedback: req.Feedback,
+		ratings := entity.ReviewRatings(req.FeedbackRatings)
+
+		review, err := u.repo.SendFeedback(ctx, models.SendFeedback{
+			BidID:         req.BidID,
+			Feedback:      req.Feedback,
+			ReviewRatings: ratings,
 		})
 		if err != nil {
 			return err
 		}
 
-		return nil
-	})
-	if err != nil {
-		return dtos.BidResponse{}, err
-	}
-
-	return dtos.NewBidResponse(resultBid), nil
-}
-
-func (u Usecase) Rollback(ctx context.Context, req dtos.RollbackRequest) (dtos.BidResponse, error) {
-	oldBid, err := u.repo.FindByIDFromHistory(ctx, req.BidID, req.Version)
-	if err != nil {
-		return dtos.BidResponse{}, err
-	}
+		if err = u.auditReview(ctx, review, entity.ReviewCreated, req.Username, nil); err != nil {
+			return err
+		}
 
-	responsible, err := u.AuthorHasPermissions(ctx, oldBid, req.Username)
+		// Reputation is updated in the same transaction, so it always matches reviews.
+		return u.supplierRepo.AddReview(ctx, req.BidID, ratings)
+	})
 	if err != nil {
 		return dtos.BidResponse{}, err
 	}
-	if !responsible {
-		return dtos.BidResponse{}, apperror.Forbidden(apperror.ErrUnauthorized)
-	}
 
-	updatedBid, err := u.repo.Update(ctx, oldBid)
+	responses, err := u.withReputation(ctx, []entity.Bid{resultBid})
 	if err != nil {
 		return dtos.BidResponse{}, err
 	}
 
-	return dtos.NewBidResponse(updatedBid), nil
+	return responses[0], nil
 }
 
-func (u Usecase) FindReviewsByTenderID(ctx context.Context, req dtos.FindReviewsRequest) ([]dtos.ReviewResponse, error) {
-	var resultReviews []entity.Review
+func (u Usecase) Rollback(ctx context.Context, req dtos.RollbackRequest) (dtos.BidResponse, error) {
+	var updatedBid entity.Bid
 	err := u.trManager.Do(ctx, func(ctx context.Context) error {
-		emp, err := u.empRepo.FindByUsername(ctx, req.RequesterUsername)
+		oldBid, err := u.repo.FindByIDFromHistory(ctx, req.BidID, req.Version)
 		if err != nil {
 			return err
 		}
 
-		author, err := u.empRepo.FindByUsername(ctx, req.AuthorUsername)
+		responsible, err := u.AuthorHasPermissions(ctx, oldBid, req.Username)
 		if err != nil {
 			return err
 		}
+		if !responsible {
+			return apperror.Forbidden(apperror.ErrUnauthorized)
+		}
 
-		org, err := u.orgRepo.GetUserOrganization(ctx, emp.ID)
+		updatedBid, err = u.repo.Update(ctx, oldBid)
 		if err != nil {
 			return err
 		}
 
-		tender, err := u.tendRepo.FindByID(ctx, req.TenderID)
-		if err != nil {
+		if err := u.repo.RestoreBidLots(ctx, updatedBid.ID, req.Version, updatedBid.Version); err != nil {
 			return err
 		}
-		if tender.OrganizationID != org.ID {
-			return apperror.Forbidden(apperror.ErrForbidden)
-		}
 
-		bidsList, err := u.repo.FindBidsByOrganization(ctx, org.ID)
-		if err != nil {
+		if err := u.attachRepo.RestoreVersion(ctx, entity.AttachmentBid, updatedBid.ID, req.Version, updatedBid.Version); err != nil {
 			return err
 		}
 
-		filteredBidsList := make([]entity.Bid, 0)
-		for _, bid := range bidsList {
-			if bid.AuthorID == author.ID {
-				filteredBidsList = append(filteredBidsList, bid)
-			}
-		}
+		// Lots of the updated bid are read again as restored.
+		updatedBid, err = u.repo.FindByID(ctx, updatedBid.ID)
 
-		reviews, err := u.repo.FindReviews(ctx, models.FindReview{
-			Bids:       filteredBidsList,
-			Pagination: req.Pagination,
-		})
-		if err != nil {
-			return err
-		}
+		return err
+	})
+	if err != nil {
+		return dtos.BidResponse{}, err
+	}
 
-		resultReviews = reviews
+	return dtos.NewBidResponse(updatedBid), nil
+}
 
-		return nil
+func (u Usecase) FindReviewsByTenderID(ctx context.Context, req dtos.FindReviewsRequest) ([]dtos.ReviewResponse, error) {
+	author, err := u.findReviewedAuthor(ctx, req.TenderID, req.AuthorUsername, req.RequesterUsername)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := u.repo.FindReviewsByAuthor(ctx, models.FindReviewsByAuthor{
+		AuthorID:   author.ID,
+		Pagination: req.Pagination,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return dtos.NewReviewResponseList(resultReviews), nil
+	return dtos.NewReviewResponseList(reviews), nil
+}
+
+func (u Usecase) GetReviewsSummary(ctx context.Context, req dtos.ReviewsSummaryRequest) (dtos.ReviewsSummaryResponse, error) {
+	author, err := u.findReviewedAuthor(ctx, req.TenderID, req.AuthorUsername, req.RequesterUsername)
+	if err != nil {
+		return dtos.ReviewsSummaryResponse{}, err
+	}
+
+	summary, err := u.repo.GetReviewsSummary(ctx, author.ID)
+	if err != nil {
+		return dtos.ReviewsSummaryResponse{}, err
+	}
+
+	return dtos.NewReviewsSummaryResponse(author.Username, summary), nil
+}
+
+// findReviewedAuthor returns the author whose reviews are requested. Reviews are available to responsibles
+// for the tender organization only.
+func (u Usecase) findReviewedAuthor(ctx context.Context, tenderID, authorUsername, requesterUsername string) (entity.Employee, error) {
+	if _, err := u.empRepo.FindByUsername(ctx, requesterUsername); err != nil {
+		return entity.Employee{}, err
+	}
+
+	author, err := u.empRepo.FindByUsername(ctx, authorUsername)
+	if err != nil {
+		return entity.Employee{}, err
+	}
+
+	tender, err := u.tendRepo.FindByID(ctx, tenderID)
+	if err != nil {
+		return entity.Employee{}, err
+	}
+
+	isResponsible, err := u.orgRepo.IsOrganizationResponsible(ctx, tender.OrganizationID, requesterUsername)
+	if err != nil {
+		return entity.Employee{}, err
+	}
+	if !isResponsible {
+		return entity.Employee{}, apperror.Forbidden(apperror.ErrForbidden)
+	}
+
+	return author, nil
+}
+
+// findActingOrganization returns the organization the user bids on behalf of. The user may be responsible in several
+// organizations, then the organization must be chosen explicitly.
+func (u Usecase) findActingOrganization(ctx context.Context, userID string, chosenID *string) (string, error) {
+	organizations, err := u.orgRepo.FindUserOrganizations(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if len(organizations) == 0 {
+		return "", apperror.Forbidden(apperror.ErrForbidden)
+	}
+
+	if chosenID == nil {
+		if len(organizations) > 1 {
+			return "", apperror.BadRequest(errors.New("organization must be chosen for member of several organizations"))
+		}
+
+		return organizations[0].ID, nil
+	}
+
+	for _, org := range organizations {
+		if org.ID == *chosenID {
+			return org.ID, nil
+		}
+	}
+
+	return "", apperror.Forbidden(apperror.ErrForbidden)
 }
 
 func (u Usecase) AuthorHasPermissions(ctx context.Context, bid entity.Bid, username string) (bool, error) {
 	switch bid.AuthorType {
 	case entity.AuthorOrganization:
-		org, err := u.orgRepo.GetUserOrganization(ctx, bid.AuthorID)
-		if err != nil {
-			return false, err
+		if bid.OrganizationID == nil {
+			fwcontext.GetLogger(ctx).Error("Organization bid without organization", "bid", bid)
+			return false, apperror.InternalServerError(apperror.ErrInternal)
 		}
 
-		isResponsible, err := u.orgRepo.IsOrganizationResponsible(ctx, org.ID, username)
+		isResponsible, err := u.orgRepo.IsOrganizationResponsible(ctx, *bid.OrganizationID, username)
 		if err != nil {
 			return false, err
 		}
@@ -435,7 +526,7 @@ func (u Usecase) AuthorHasPermissions(ctx context.Context, bid entity.Bid, usern
 			return false, apperror.Forbidden(apperror.ErrForbidden)
 		}
 	default:
-		slog.Error("Unknown author type", "bid", bid)
+		fwcontext.GetLogger(ctx).Error("Unknown author type", "bid", bid)
 		return false, apperror.InternalServerError(apperror.ErrInternal)
 	}
 
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"avito-tenders/internal/api/bids/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+)
+
+// approvalQuorum is the number of equal votes that decides the bid, organizations with fewer responsibles need
+// votes of all of them.
+const approvalQuorum = 3
+
+// RetractDecision removes the vote of the responsible while the bid is not decided yet.
+func (u Usecase) RetractDecision(ctx context.Context, req dtos.RetractDecisionRequest) (dtos.BidResponse, error) {
+	var resultBid entity.Bid
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		bid, err := u.repo.FindByID(ctx, req.BidID)
+		if err != nil {
+			return err
+		}
+		resultBid = bid
+
+		if err = u.checkTenderResponsible(ctx, bid.TenderID, req.Username); err != nil {
+			return err
+		}
+
+		if bid.Status != entity.BidPublished {
+			return apperror.BadRequest(errors.New("bid is not open for voting"))
+		}
+
+		user, err := u.empRepo.FindByUsername(ctx, req.Username)
+		if err != nil {
+			return err
+		}
+
+		// Retraction never reaches quorum, so the bid status stays the same.
+		return u.repo.RetractVote(ctx, bid.ID, user.ID)
+	})
+	if err != nil {
+		return dtos.BidResponse{}, err
+	}
+
+	return dtos.NewBidResponse(resultBid), nil
+}
+
+// GetVoteTally returns votes on the bid to responsibles for the tender organization.
+func (u Usecase) GetVoteTally(ctx context.Context, req dtos.VoteTallyRequest) (dtos.VoteTallyResponse, error) {
+	bid, err := u.repo.FindByID(ctx, req.BidID)
+	if err != nil {
+		return dtos.VoteTallyResponse{}, err
+	}
+
+	tender, err := u.tendRepo.FindByID(ctx, bid.TenderID)
+	if err != nil {
+		return dtos.VoteTallyResponse{}, err
+	}
+
+	if err = u.checkTenderResponsible(ctx, tender.ID, req.Username); err != nil {
+		return dtos.VoteTallyResponse{}, err
+	}
+
+	votes, err := u.repo.FindVotes(ctx, bid.ID)
+	if err != nil {
+		return dtos.VoteTallyResponse{}, err
+	}
+
+	quorum, err := u.quorum(ctx, tender)
+	if err != nil {
+		return dtos.VoteTallyResponse{}, err
+	}
+
+	return dtos.NewVoteTallyResponse(bid, quorum, votes), nil
+}
+
+// applyVotes approves or rejects the bid when quorum is reached. Approved bid is awarded on behalf of the user
+// whose vote reached quorum, it reports whether the tender is closed then.
+func (u Usecase) applyVotes(ctx context.Context, tender entity.Tender, bid entity.Bid, username string) (entity.Bid, bool, error) {
+	votes, err := u.repo.FindVotes(ctx, bid.ID)
+	if err != nil {
+		return entity.Bid{}, false, err
+	}
+
+	quorum, err := u.quorum(ctx, tender)
+	if err != nil {
+		return entity.Bid{}, false, err
+	}
+
+	tally := entity.TallyVotes(votes)
+	switch {
+	case tally.Approved >= quorum:
+		bid.Status = entity.BidApproved
+	case tally.Rejected >= quorum:
+		bid.Status = entity.BidRejected
+	default:
+		return bid, false, nil
+	}
+
+	updatedBid, err := u.repo.Update(ctx, bid)
+	if err != nil {
+		return entity.Bid{}, false, err
+	}
+
+	if updatedBid.Status != entity.BidApproved {
+		return updatedBid, false, nil
+	}
+
+	// Award targeted lots and close tender once nothing is left to award.
+	tenderClosed, err := u.awardBid(ctx, tender, updatedBid, username)
+	if err != nil {
+		return entity.Bid{}, false, err
+	}
+
+	return updatedBid, tenderClosed, nil
+}
+
+func (u Usecase) quorum(ctx context.Context, tender entity.Tender) (int, error) {
+	responsibleList, err := u.orgRepo.GetOrganizationResponsible(ctx, tender.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+
+	return min(approvalQuorum, len(responsibleList)), nil
+}
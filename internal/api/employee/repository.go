@@ -9,4 +9,5 @@ import (
 type Repository interface {
 	FindByUsername(ctx context.Context, username string) (entity.Employee, error)
 	FindByID(ctx context.Context, id string) (entity.Employee, error)
+	FindByIDs(ctx context.Context, ids []string) ([]entity.Employee, error)
 }
@@ -4,13 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"log/slog"
 
 	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"avito-tenders/internal/entity"
 	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
 )
 
 type Repository struct {
@@ -36,7 +37,7 @@ func (r Repository) FindByUsername(ctx context.Context, username string) (entity
 			return entity.Employee{}, apperror.Unauthorized(apperror.ErrUserDoesNotExist)
 		}
 
-		slog.Error("couldn't scan employee found by username", "error", err)
+		fwcontext.GetLogger(ctx).Error("couldn't scan employee found by username", "error", err)
 
 		return entity.Employee{}, apperror.BadRequest(apperror.ErrInternal)
 	}
@@ -58,10 +59,25 @@ func (r Repository) FindByID(ctx context.Context, id string) (entity.Employee, e
 			return entity.Employee{}, apperror.Unauthorized(apperror.ErrUserDoesNotExist)
 		}
 
-		slog.Error("couldn't scan employee found by id", "error", err)
+		fwcontext.GetLogger(ctx).Error("couldn't scan employee found by id", "error", err)
 
 		return entity.Employee{}, apperror.BadRequest(apperror.ErrInternal)
 	}
 
 	return emp, nil
 }
+
+func (r Repository) FindByIDs(ctx context.Context, ids []string) ([]entity.Employee, error) {
+	empList := make([]entity.Employee, 0, len(ids))
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &empList, `
+	select id, username, coalesce(first_name, '') as first_name, coalesce(last_name, '') as last_name, created_at, updated_at
+	from employee
+	where id = any($1::uuid[])`, pq.Array(ids))
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find employees by ids", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return empList, nil
+}
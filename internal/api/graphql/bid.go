@@ -0,0 +1,171 @@
+package graphql
+
+import (
+	"context"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/types"
+)
+
+type bidResolver struct {
+	bid entity.Bid
+}
+
+func (r *bidResolver) ID() graphqlgo.ID {
+	return graphqlgo.ID(r.bid.ID)
+}
+
+func (r *bidResolver) Name() string {
+	return r.bid.Name
+}
+
+func (r *bidResolver) Description() string {
+	return r.bid.Description
+}
+
+func (r *bidResolver) Status() entity.BidStatus {
+	return r.bid.Status
+}
+
+func (r *bidResolver) AuthorType() entity.AuthorType {
+	return r.bid.AuthorType
+}
+
+func (r *bidResolver) Version() int32 {
+	return int32(r.bid.Version)
+}
+
+func (r *bidResolver) CreatedAt() string {
+	return types.RFCFromTime(r.bid.CreatedAt).String()
+}
+
+func (r *bidResolver) Tender(ctx context.Context) (*tenderResolver, error) {
+	l := getLoaders(ctx)
+
+	tender, found, err := l.tenders.Load(ctx, r.bid.TenderID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, apperror.NotFound(apperror.ErrNotFound)
+	}
+
+	v, err := l.getViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !canViewTender(v, tender) {
+		return nil, deny(v)
+	}
+
+	return &tenderResolver{tender: tender}, nil
+}
+
+func (r *bidResolver) Author(ctx context.Context) (*employeeResolver, error) {
+	return loadEmployee(ctx, r.bid.AuthorID)
+}
+
+func (r *bidResolver) Organization(ctx context.Context) (*organizationResolver, error) {
+	if r.bid.AuthorType != entity.AuthorOrganization || r.bid.OrganizationID == nil {
+		return nil, nil
+	}
+
+	return loadOrganization(ctx, *r.bid.OrganizationID)
+}
+
+func (r *bidResolver) Reviews(ctx context.Context) (*[]*reviewResolver, error) {
+	l := getLoaders(ctx)
+
+	v, err := l.getViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tender, found, err := l.tenders.Load(ctx, r.bid.TenderID)
+	if err != nil {
+		return nil, err
+	}
+	if !found || !isResponsible(v, tender.OrganizationID) {
+		return nil, deny(v)
+	}
+
+	reviewsList, _, err := l.bidReviews.Load(ctx, r.bid.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*reviewResolver, 0, len(reviewsList))
+	for _, review := range reviewsList {
+		resolvers = append(resolvers, &reviewResolver{review: review})
+	}
+
+	return &resolvers, nil
+}
+
+func (r *bidResolver) History(ctx context.Context) (*[]*bidVersionResolver, error) {
+	l := getLoaders(ctx)
+
+	v, err := l.getViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isAuthor, err := isBidAuthor(ctx, v, r.bid)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthor {
+		return nil, deny(v)
+	}
+
+	versions, _, err := l.bidHistory.Load(ctx, r.bid.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*bidVersionResolver, 0, len(versions))
+	for _, version := range versions {
+		resolvers = append(resolvers, &bidVersionResolver{bid: version})
+	}
+
+	return &resolvers, nil
+}
+
+type bidVersionResolver struct {
+	bid entity.Bid
+}
+
+func (r *bidVersionResolver) Version() int32 {
+	return int32(r.bid.Version)
+}
+
+func (r *bidVersionResolver) Name() string {
+	return r.bid.Name
+}
+
+func (r *bidVersionResolver) Description() string {
+	return r.bid.Description
+}
+
+func (r *bidVersionResolver) Status() entity.BidStatus {
+	return r.bid.Status
+}
+
+type reviewResolver struct {
+	review entity.Review
+}
+
+func (r *reviewResolver) ID() graphqlgo.ID {
+	return graphqlgo.ID(r.review.ID)
+}
+
+func (r *reviewResolver) Description() string {
+	return r.review.Description
+}
+
+func (r *reviewResolver) CreatedAt() string {
+	return types.RFCFromTime(r.review.CreatedAt).String()
+}
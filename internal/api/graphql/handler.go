@@ -0,0 +1,83 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+// Request is the GraphQL request sent in JSON body.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// NewHandler returns handler executing GraphQL requests against the schema with loaders over the repositories.
+func NewHandler(repos Opts) (http.HandlerFunc, error) {
+	schema, err := NewSchema(repos)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+			return
+		}
+
+		var request Request
+		if err := json.Unmarshal(body, &request); err != nil || request.Query == "" {
+			apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+			return
+		}
+
+		ctx := withLoaders(r.Context(), newLoaders(repos))
+
+		response := schema.Exec(ctx, request.Query, request.OperationName, request.Variables)
+		describeErrors(ctx, response.Errors)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			fwcontext.GetLogger(ctx).Error("failed to send GraphQL response", "error", err)
+		}
+	}, nil
+}
+
+// describeErrors replaces messages of resolver errors with the problem details used by REST API and puts the problem
+// code and status to error extensions.
+func describeErrors(ctx context.Context, errs []*gqlerrors.QueryError) {
+	lang := fwcontext.GetLanguage(ctx)
+
+	for _, queryErr := range errs {
+		if queryErr.ResolverError == nil {
+			continue
+		}
+
+		var appErr *apperror.AppError
+		if !errors.As(queryErr.ResolverError, &appErr) {
+			fwcontext.GetLogger(ctx).Error("unexpected GraphQL resolver error", "error", queryErr.ResolverError)
+			appErr = apperror.InternalServerError(queryErr.ResolverError).(*apperror.AppError)
+		}
+
+		problem := apperror.NewProblem(appErr, lang)
+
+		queryErr.Message = problem.Detail
+		queryErr.Extensions = map[string]interface{}{
+			"code":   problem.Code,
+			"status": problem.Status,
+		}
+		if len(problem.Errors) > 0 {
+			queryErr.Extensions["errors"] = problem.Errors
+		}
+	}
+}
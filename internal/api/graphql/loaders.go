@@ -0,0 +1,150 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/dataloader"
+	"avito-tenders/pkg/fwcontext"
+)
+
+type loadersCtxKey struct{}
+
+// loaders are request-scoped batching loaders of entities and the requester.
+type loaders struct {
+	tenders           *dataloader.Loader[string, entity.Tender]
+	tenderHistory     *dataloader.Loader[string, []entity.Tender]
+	bids              *dataloader.Loader[string, entity.Bid]
+	tenderBids        *dataloader.Loader[string, []entity.Bid]
+	bidHistory        *dataloader.Loader[string, []entity.Bid]
+	bidReviews        *dataloader.Loader[string, []entity.Review]
+	organizations     *dataloader.Loader[string, entity.Organization]
+	responsibles      *dataloader.Loader[string, []string]
+	userOrganizations *dataloader.Loader[string, []string]
+	employees         *dataloader.Loader[string, entity.Employee]
+
+	viewerOnce sync.Once
+	viewer     *viewer
+	viewerErr  error
+	repos      Opts
+}
+
+// viewer is the employee the request is made by and organizations they are responsible for.
+type viewer struct {
+	employee      entity.Employee
+	organizations map[string]struct{}
+}
+
+func newLoaders(repos Opts) *loaders {
+	return &loaders{
+		repos: repos,
+		tenders: dataloader.New(func(ctx context.Context, ids []string) (map[string]entity.Tender, error) {
+			tenderList, err := repos.TenderRepo.FindByIDs(ctx, ids)
+			return mapBy(tenderList, func(t entity.Tender) string { return t.ID }), err
+		}),
+		tenderHistory: dataloader.New(func(ctx context.Context, ids []string) (map[string][]entity.Tender, error) {
+			tenderList, err := repos.TenderRepo.FindHistoryByIDs(ctx, ids)
+			return groupBy(tenderList, func(t entity.Tender) string { return t.ID }), err
+		}),
+		bids: dataloader.New(func(ctx context.Context, ids []string) (map[string]entity.Bid, error) {
+			bidsList, err := repos.BidRepo.FindByIDs(ctx, ids)
+			return mapBy(bidsList, func(b entity.Bid) string { return b.ID }), err
+		}),
+		tenderBids: dataloader.New(func(ctx context.Context, tenderIDs []string) (map[string][]entity.Bid, error) {
+			bidsList, err := repos.BidRepo.FindByTenderIDs(ctx, tenderIDs)
+			return groupBy(bidsList, func(b entity.Bid) string { return b.TenderID }), err
+		}),
+		bidHistory: dataloader.New(func(ctx context.Context, ids []string) (map[string][]entity.Bid, error) {
+			bidsList, err := repos.BidRepo.FindHistoryByIDs(ctx, ids)
+			return groupBy(bidsList, func(b entity.Bid) string { return b.ID }), err
+		}),
+		bidReviews: dataloader.New(func(ctx context.Context, bidIDs []string) (map[string][]entity.Review, error) {
+			reviewsList, err := repos.BidRepo.FindReviewsByBidIDs(ctx, bidIDs)
+			return groupBy(reviewsList, func(r entity.Review) string { return r.BidID }), err
+		}),
+		organizations: dataloader.New(func(ctx context.Context, ids []string) (map[string]entity.Organization, error) {
+			orgList, err := repos.OrgRepo.FindByIDs(ctx, ids)
+			return mapBy(orgList, func(o entity.Organization) string { return o.ID }), err
+		}),
+		responsibles: dataloader.New(func(ctx context.Context, orgIDs []string) (map[string][]string, error) {
+			responsibleList, err := repos.OrgRepo.FindResponsibles(ctx, orgIDs)
+			return groupMap(responsibleList,
+				func(r entity.OrganizationResponsible) string { return r.OrganizationID },
+				func(r entity.OrganizationResponsible) string { return r.UserID }), err
+		}),
+		userOrganizations: dataloader.New(func(ctx context.Context, userIDs []string) (map[string][]string, error) {
+			responsibleList, err := repos.OrgRepo.FindUserResponsibilities(ctx, userIDs)
+			return groupMap(responsibleList,
+				func(r entity.OrganizationResponsible) string { return r.UserID },
+				func(r entity.OrganizationResponsible) string { return r.OrganizationID }), err
+		}),
+		employees: dataloader.New(func(ctx context.Context, ids []string) (map[string]entity.Employee, error) {
+			empList, err := repos.EmpRepo.FindByIDs(ctx, ids)
+			return mapBy(empList, func(e entity.Employee) string { return e.ID }), err
+		}),
+	}
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersCtxKey{}, l)
+}
+
+func getLoaders(ctx context.Context) *loaders {
+	l, _ := ctx.Value(loadersCtxKey{}).(*loaders)
+
+	return l
+}
+
+// getViewer returns the requester, nil for anonymous requests. The username is checked by the middleware, so it exists.
+func (l *loaders) getViewer(ctx context.Context) (*viewer, error) {
+	l.viewerOnce.Do(func() {
+		username := fwcontext.GetUsername(ctx)
+		if username == "" {
+			return
+		}
+
+		emp, err := l.repos.EmpRepo.FindByUsername(ctx, username)
+		if err != nil {
+			l.viewerErr = err
+			return
+		}
+
+		orgIDs, _, err := l.userOrganizations.Load(ctx, emp.ID)
+		if err != nil {
+			l.viewerErr = err
+			return
+		}
+
+		v := &viewer{employee: emp, organizations: make(map[string]struct{}, len(orgIDs))}
+		for _, id := range orgIDs {
+			v.organizations[id] = struct{}{}
+		}
+		l.employees.Prime(emp.ID, emp)
+		l.viewer = v
+	})
+
+	return l.viewer, l.viewerErr
+}
+
+func mapBy[K comparable, V any](values []V, key func(V) K) map[K]V {
+	result := make(map[K]V, len(values))
+	for _, v := range values {
+		result[key(v)] = v
+	}
+
+	return result
+}
+
+func groupBy[K comparable, V any](values []V, key func(V) K) map[K][]V {
+	return groupMap(values, key, func(v V) V { return v })
+}
+
+func groupMap[K comparable, V, R any](values []V, key func(V) K, value func(V) R) map[K][]R {
+	result := make(map[K][]R)
+	for _, v := range values {
+		result[key(v)] = append(result[key(v)], value(v))
+	}
+
+	return result
+}
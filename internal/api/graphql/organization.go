@@ -0,0 +1,138 @@
+package graphql
+
+import (
+	"context"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+)
+
+type organizationResolver struct {
+	org entity.Organization
+}
+
+func loadOrganization(ctx context.Context, id string) (*organizationResolver, error) {
+	org, found, err := getLoaders(ctx).organizations.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, apperror.NotFound(apperror.ErrNotFound)
+	}
+
+	return &organizationResolver{org: org}, nil
+}
+
+// loadUserOrganizations returns organizations the user is responsible in.
+func loadUserOrganizations(ctx context.Context, userID string) ([]*organizationResolver, error) {
+	l := getLoaders(ctx)
+
+	orgIDs, _, err := l.userOrganizations.Load(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	orgList, err := l.organizations.LoadMany(ctx, orgIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*organizationResolver, 0, len(orgList))
+	for _, org := range orgList {
+		resolvers = append(resolvers, &organizationResolver{org: org})
+	}
+
+	return resolvers, nil
+}
+
+func (r *organizationResolver) ID() graphqlgo.ID {
+	return graphqlgo.ID(r.org.ID)
+}
+
+func (r *organizationResolver) Name() string {
+	return r.org.Name
+}
+
+func (r *organizationResolver) Description() string {
+	return r.org.Description
+}
+
+func (r *organizationResolver) Type() string {
+	return string(r.org.Type)
+}
+
+func (r *organizationResolver) Responsibles(ctx context.Context) (*[]*employeeResolver, error) {
+	l := getLoaders(ctx)
+
+	v, err := l.getViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isResponsible(v, r.org.ID) {
+		return nil, deny(v)
+	}
+
+	userIDs, _, err := l.responsibles.Load(ctx, r.org.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	empList, err := l.employees.LoadMany(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*employeeResolver, 0, len(empList))
+	for _, emp := range empList {
+		resolvers = append(resolvers, &employeeResolver{emp: emp})
+	}
+
+	return &resolvers, nil
+}
+
+type employeeResolver struct {
+	emp entity.Employee
+}
+
+func loadEmployee(ctx context.Context, id string) (*employeeResolver, error) {
+	emp, found, err := getLoaders(ctx).employees.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, apperror.NotFound(apperror.ErrNotFound)
+	}
+
+	return &employeeResolver{emp: emp}, nil
+}
+
+func (r *employeeResolver) ID() graphqlgo.ID {
+	return graphqlgo.ID(r.emp.ID)
+}
+
+func (r *employeeResolver) Username() string {
+	return r.emp.Username
+}
+
+func (r *employeeResolver) FirstName() string {
+	return r.emp.FirstName
+}
+
+func (r *employeeResolver) LastName() string {
+	return r.emp.LastName
+}
+
+func (r *employeeResolver) Organization(ctx context.Context) (*organizationResolver, error) {
+	resolvers, err := loadUserOrganizations(ctx, r.emp.ID)
+	if err != nil || len(resolvers) == 0 {
+		return nil, err
+	}
+
+	return resolvers[0], nil
+}
+
+func (r *employeeResolver) Organizations(ctx context.Context) ([]*organizationResolver, error) {
+	return loadUserOrganizations(ctx, r.emp.ID)
+}
@@ -0,0 +1,70 @@
+package graphql
+
+import (
+	"context"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+)
+
+// deny returns error for the entity the requester has no access to: anonymous requesters are asked to authenticate.
+func deny(v *viewer) error {
+	if v == nil {
+		return apperror.Unauthorized(apperror.ErrUserEmpty)
+	}
+
+	return apperror.Forbidden(apperror.ErrForbidden)
+}
+
+// isResponsible checks if the requester is responsible in the organization.
+func isResponsible(v *viewer, organizationID string) bool {
+	if v == nil {
+		return false
+	}
+
+	_, ok := v.organizations[organizationID]
+
+	return ok
+}
+
+// canViewTender checks if the tender is published or the requester is responsible for it, as GET /tenders/{id}/status.
+func canViewTender(v *viewer, tender entity.Tender) bool {
+	return tender.Status == entity.TenderPublished || isResponsible(v, tender.OrganizationID)
+}
+
+// isBidAuthor checks if the requester created the bid or is responsible in the organization that created it,
+// as bids usecase AuthorHasPermissions.
+func isBidAuthor(ctx context.Context, v *viewer, bid entity.Bid) (bool, error) {
+	if v == nil {
+		return false, nil
+	}
+
+	switch bid.AuthorType {
+	case entity.AuthorOrganization:
+		return bid.OrganizationID != nil && isResponsible(v, *bid.OrganizationID), nil
+	case entity.AuthorUser:
+		return v.employee.ID == bid.AuthorID, nil
+	default:
+		return false, nil
+	}
+}
+
+// canViewBid checks if the requester is the bid author or the bid is published for the requester's tender,
+// as GET /bids/{tenderId}/list.
+func canViewBid(ctx context.Context, v *viewer, bid entity.Bid) (bool, error) {
+	isAuthor, err := isBidAuthor(ctx, v, bid)
+	if err != nil || isAuthor {
+		return isAuthor, err
+	}
+
+	if bid.Status != entity.BidPublished || v == nil {
+		return false, nil
+	}
+
+	tender, found, err := getLoaders(ctx).tenders.Load(ctx, bid.TenderID)
+	if err != nil || !found {
+		return false, err
+	}
+
+	return isResponsible(v, tender.OrganizationID), nil
+}
@@ -0,0 +1,171 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+
+	"avito-tenders/internal/api/tenders"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+	"avito-tenders/pkg/queryparams"
+)
+
+type queryResolver struct {
+	repos Opts
+}
+
+type paginationArgs struct {
+	Limit  *int32
+	Offset *int32
+}
+
+func (r *queryResolver) Tenders(ctx context.Context, args struct {
+	ServiceTypes *[]string
+	Limit        *int32
+	Offset       *int32
+}) ([]*tenderResolver, error) {
+	pagination, err := queryparams.NewPagination(args.Limit, args.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter tenders.TenderFilter
+	if args.ServiceTypes != nil {
+		for _, serviceType := range *args.ServiceTypes {
+			filter.ServiceTypes = append(filter.ServiceTypes, entity.ServiceType(serviceType))
+		}
+	}
+
+	tenderList, err := r.repos.TenderRepo.GetAll(ctx, filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTenderResolvers(ctx, tenderList), nil
+}
+
+func (r *queryResolver) MyTenders(ctx context.Context, args paginationArgs) ([]*tenderResolver, error) {
+	v, err := getLoaders(ctx).getViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, deny(v)
+	}
+
+	pagination, err := queryparams.NewPagination(args.Limit, args.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	tenderList, err := r.repos.TenderRepo.FindByCreatorUsername(ctx, fwcontext.GetUsername(ctx), pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTenderResolvers(ctx, tenderList), nil
+}
+
+func (r *queryResolver) Tender(ctx context.Context, args struct{ ID graphqlgo.ID }) (*tenderResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	l := getLoaders(ctx)
+
+	tender, found, err := l.tenders.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, apperror.NotFound(apperror.ErrNotFound)
+	}
+
+	v, err := l.getViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !canViewTender(v, tender) {
+		return nil, deny(v)
+	}
+
+	return &tenderResolver{tender: tender}, nil
+}
+
+func (r *queryResolver) Bid(ctx context.Context, args struct{ ID graphqlgo.ID }) (*bidResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	l := getLoaders(ctx)
+
+	bid, found, err := l.bids.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, apperror.NotFound(apperror.ErrNotFound)
+	}
+
+	v, err := l.getViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	canView, err := canViewBid(ctx, v, bid)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return nil, deny(v)
+	}
+
+	return &bidResolver{bid: bid}, nil
+}
+
+func (r *queryResolver) Organization(ctx context.Context, args struct{ ID graphqlgo.ID }) (*organizationResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadOrganization(ctx, id)
+}
+
+func (r *queryResolver) Viewer(ctx context.Context) (*employeeResolver, error) {
+	v, err := getLoaders(ctx).getViewer(ctx)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	return &employeeResolver{emp: v.employee}, nil
+}
+
+// parseID checks that id is UUID, as ids of all entities are.
+func parseID(id graphqlgo.ID) (string, error) {
+	if err := uuid.Validate(string(id)); err != nil {
+		return "", apperror.BadRequest(fmt.Errorf("invalid id %q", id))
+	}
+
+	return string(id), nil
+}
+
+// paginate returns the page of values.
+func paginate[T any](values []T, pagination queryparams.Pagination) []T {
+	if pagination.Offset >= len(values) {
+		return []T{}
+	}
+
+	values = values[pagination.Offset:]
+	if pagination.Limit < len(values) {
+		values = values[:pagination.Limit]
+	}
+
+	return values
+}
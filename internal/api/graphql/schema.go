@@ -0,0 +1,65 @@
+// Package graphql implements GraphQL API over tenders, bids, organizations, employees and reviews.
+//
+// Resolvers read repositories directly through request-scoped loaders, so entities requested by items of a list are
+// fetched in one query. Permission checks mirror the ones of REST usecases.
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	gqlotel "github.com/graph-gophers/graphql-go/trace/otel"
+
+	"avito-tenders/internal/api/bids"
+	"avito-tenders/internal/api/employee"
+	"avito-tenders/internal/api/organization"
+	"avito-tenders/internal/api/tenders"
+	"avito-tenders/pkg/fwcontext"
+	"avito-tenders/pkg/tracing"
+)
+
+const (
+	// maxDepth limits nesting of queries, e.g. tender.bids.tender.bids.
+	maxDepth = 8
+
+	// maxParallelism is the number of concurrently resolved fields. Loads of concurrently resolved fields are batched,
+	// so it is not less than the maximal page size.
+	maxParallelism = 64
+)
+
+// SDL is the GraphQL schema definition.
+//
+//go:embed schema.graphql
+var SDL string
+
+type Opts struct {
+	TenderRepo tenders.Repository
+	BidRepo    bids.Repository
+	OrgRepo    organization.Repository
+	EmpRepo    employee.Repository
+}
+
+// NewSchema parses the schema and binds it to resolvers over the repositories.
+func NewSchema(opts Opts) (*graphqlgo.Schema, error) {
+	schema, err := graphqlgo.ParseSchema(SDL, &queryResolver{repos: opts},
+		graphqlgo.MaxDepth(maxDepth),
+		graphqlgo.MaxParallelism(maxParallelism),
+		graphqlgo.Tracer(&gqlotel.Tracer{Tracer: tracing.Tracer()}),
+		graphqlgo.Logger(panicLogger{}),
+		graphqlgo.UseStringDescriptions(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse GraphQL schema: %w", err)
+	}
+
+	return schema, nil
+}
+
+// panicLogger logs panics of resolvers with the request-scoped logger.
+type panicLogger struct{}
+
+func (panicLogger) LogPanic(ctx context.Context, value interface{}) {
+	fwcontext.GetLogger(ctx).Error("GraphQL resolver panicked", "panic", value)
+}
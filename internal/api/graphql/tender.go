@@ -0,0 +1,147 @@
+package graphql
+
+import (
+	"context"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/queryparams"
+	"avito-tenders/pkg/types"
+)
+
+type tenderResolver struct {
+	tender entity.Tender
+}
+
+// newTenderResolvers returns resolvers of the tenders and caches them for nested fields.
+func newTenderResolvers(ctx context.Context, tenderList []entity.Tender) []*tenderResolver {
+	l := getLoaders(ctx)
+
+	resolvers := make([]*tenderResolver, 0, len(tenderList))
+	for _, tender := range tenderList {
+		l.tenders.Prime(tender.ID, tender)
+		resolvers = append(resolvers, &tenderResolver{tender: tender})
+	}
+
+	return resolvers
+}
+
+func (r *tenderResolver) ID() graphqlgo.ID {
+	return graphqlgo.ID(r.tender.ID)
+}
+
+func (r *tenderResolver) Name() string {
+	return r.tender.Name
+}
+
+func (r *tenderResolver) Description() string {
+	return r.tender.Description
+}
+
+func (r *tenderResolver) ServiceType() entity.ServiceType {
+	return r.tender.ServiceType
+}
+
+func (r *tenderResolver) Status() entity.TenderStatus {
+	return r.tender.Status
+}
+
+func (r *tenderResolver) Version() int32 {
+	return int32(r.tender.Version)
+}
+
+func (r *tenderResolver) CreatedAt() string {
+	return types.RFCFromTime(r.tender.CreatedAt).String()
+}
+
+func (r *tenderResolver) Organization(ctx context.Context) (*organizationResolver, error) {
+	return loadOrganization(ctx, r.tender.OrganizationID)
+}
+
+func (r *tenderResolver) Bids(ctx context.Context, args paginationArgs) ([]*bidResolver, error) {
+	pagination, err := queryparams.NewPagination(args.Limit, args.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	l := getLoaders(ctx)
+
+	v, err := l.getViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bidsList, _, err := l.tenderBids.Load(ctx, r.tender.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bids are filtered before pagination, so pages are not shortened by bids the requester cannot see.
+	visibleBids := make([]entity.Bid, 0, len(bidsList))
+	for _, bid := range bidsList {
+		canView, err := canViewBid(ctx, v, bid)
+		if err != nil {
+			return nil, err
+		}
+		if canView {
+			visibleBids = append(visibleBids, bid)
+		}
+	}
+
+	resolvers := make([]*bidResolver, 0, pagination.Limit)
+	for _, bid := range paginate(visibleBids, pagination) {
+		l.bids.Prime(bid.ID, bid)
+		resolvers = append(resolvers, &bidResolver{bid: bid})
+	}
+
+	return resolvers, nil
+}
+
+func (r *tenderResolver) History(ctx context.Context) (*[]*tenderVersionResolver, error) {
+	l := getLoaders(ctx)
+
+	v, err := l.getViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isResponsible(v, r.tender.OrganizationID) {
+		return nil, deny(v)
+	}
+
+	versions, _, err := l.tenderHistory.Load(ctx, r.tender.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*tenderVersionResolver, 0, len(versions))
+	for _, version := range versions {
+		resolvers = append(resolvers, &tenderVersionResolver{tender: version})
+	}
+
+	return &resolvers, nil
+}
+
+type tenderVersionResolver struct {
+	tender entity.Tender
+}
+
+func (r *tenderVersionResolver) Version() int32 {
+	return int32(r.tender.Version)
+}
+
+func (r *tenderVersionResolver) Name() string {
+	return r.tender.Name
+}
+
+func (r *tenderVersionResolver) Description() string {
+	return r.tender.Description
+}
+
+func (r *tenderVersionResolver) ServiceType() entity.ServiceType {
+	return r.tender.ServiceType
+}
+
+func (r *tenderVersionResolver) Status() entity.TenderStatus {
+	return r.tender.Status
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	bidsGrpc "avito-tenders/internal/api/bids/delivery/grpc"
+	"avito-tenders/internal/api/interceptors"
+	tendersGrpc "avito-tenders/internal/api/tenders/delivery/grpc"
+	"avito-tenders/pkg/backend"
+)
+
+// InitGRPCServer creates gRPC server with tender, bid and review services. Services use the same usecases and
+// the same authentication as HTTP API, errors are converted to gRPC statuses.
+func InitGRPCServer(b backend.Backend) *grpc.Server {
+	uc := newUsecases(b)
+
+	publicMethods := make([]string, 0, len(tendersGrpc.PublicMethods)+len(bidsGrpc.PublicMethods))
+	publicMethods = append(publicMethods, tendersGrpc.PublicMethods...)
+	publicMethods = append(publicMethods, bidsGrpc.PublicMethods...)
+
+	interceptorsManager := interceptors.NewManager(uc.empRepo, publicMethods...)
+
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		interceptors.LoggerInterceptor,
+		interceptors.ErrorsInterceptor,
+		interceptors.RecoveryInterceptor,
+		interceptorsManager.UserExistsInterceptor,
+	))
+
+	tendersGrpc.NewServer(uc.tenders).RegisterTenderService(server)
+	bidsGrpc.NewServer(uc.bids).RegisterBidServices(server)
+
+	// Reflection lets tools like grpcurl call the services without proto files.
+	reflection.Register(server)
+
+	slog.Info("gRPC services initialized")
+
+	return server
+}
@@ -0,0 +1,44 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+	"avito-tenders/pkg/i18n"
+)
+
+// ErrorsInterceptor negotiates language by accept-language metadata and converts errors of handlers to gRPC statuses
+// translated to the language, so gRPC clients get the same errors as HTTP ones.
+func ErrorsInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	lang := i18n.Negotiate(metadataValue(ctx, acceptLanguageMetadataKey))
+	if err := grpc.SetHeader(ctx, metadata.Pairs(contentLanguageMetadataKey, string(lang))); err != nil {
+		fwcontext.GetLogger(ctx).Warn("failed to set content language", "error", err)
+	}
+
+	ctx = context.WithValue(ctx, fwcontext.LanguageCtxKey, lang)
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, apperror.GRPCStatus(err, lang).Err()
+	}
+
+	return resp, nil
+}
+
+// RecoveryInterceptor converts panics of handlers to internal errors, like Recoverer middleware does for HTTP routes.
+func RecoveryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			fwcontext.GetLogger(ctx).Error("handler panicked", "method", info.FullMethod, "panic", p, "stack", string(debug.Stack()))
+			err = apperror.InternalServerError(fmt.Errorf("panic: %v", p))
+		}
+	}()
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,64 @@
+package interceptors
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"avito-tenders/pkg/fwcontext"
+)
+
+type accessLogCtxKey struct{}
+
+// accessLogEntry collects request attributes known only to inner interceptors.
+type accessLogEntry struct {
+	username string
+}
+
+// LoggerInterceptor puts request-scoped logger into the context and writes access log entry when request is handled.
+func LoggerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+
+	logger := slog.Default()
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+		logger = logger.With(slog.String("traceId", spanContext.TraceID().String()))
+	}
+
+	entry := &accessLogEntry{}
+
+	ctx = fwcontext.WithLogger(ctx, logger)
+	ctx = context.WithValue(ctx, accessLogCtxKey{}, entry)
+
+	resp, err := handler(ctx, req)
+
+	code := status.Code(err)
+
+	level := slog.LevelInfo
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		level = slog.LevelError
+	}
+
+	logger.Log(ctx, level, "request handled",
+		slog.String("method", info.FullMethod),
+		slog.String("code", code.String()),
+		slog.Float64("latencyMs", float64(time.Since(start).Microseconds())/1000),
+		slog.String("username", entry.username),
+	)
+
+	return resp, err
+}
+
+// setRequestUsername adds username to the request-scoped logger and access log entry.
+func setRequestUsername(ctx context.Context, username string) context.Context {
+	if entry, ok := ctx.Value(accessLogCtxKey{}).(*accessLogEntry); ok {
+		entry.username = username
+	}
+
+	return fwcontext.WithLogger(ctx, fwcontext.GetLogger(ctx).With(slog.String("username", username)))
+}
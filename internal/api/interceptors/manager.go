@@ -0,0 +1,22 @@
+package interceptors
+
+import (
+	"avito-tenders/internal/api/employee"
+)
+
+// Manager contains dependencies of gRPC interceptors.
+type Manager struct {
+	empRepo       employee.Repository
+	publicMethods map[string]struct{}
+}
+
+// NewManager creates manager, public methods may be called without username like HTTP routes without
+// UserExistsMiddleware.
+func NewManager(empRepo employee.Repository, publicMethods ...string) *Manager {
+	public := make(map[string]struct{}, len(publicMethods))
+	for _, method := range publicMethods {
+		public[method] = struct{}{}
+	}
+
+	return &Manager{empRepo: empRepo, publicMethods: public}
+}
@@ -0,0 +1,25 @@
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// usernameMetadataKey is the metadata key of the user, it is the same as username query parameter of HTTP API.
+	usernameMetadataKey = "username"
+
+	acceptLanguageMetadataKey  = "accept-language"
+	contentLanguageMetadataKey = "content-language"
+)
+
+// metadataValue returns the first value of the incoming metadata key or empty string.
+func metadataValue(ctx context.Context, key string) string {
+	values := metadata.ValueFromIncomingContext(ctx, key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -0,0 +1,32 @@
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+// UserExistsInterceptor checks that the user from username metadata exists, like UserExistsMiddleware does for HTTP
+// routes. Username may be omitted for public methods, but if it is given, the user must exist.
+func (m *Manager) UserExistsInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	username := metadataValue(ctx, usernameMetadataKey)
+	if username == "" {
+		if _, public := m.publicMethods[info.FullMethod]; public {
+			return handler(ctx, req)
+		}
+
+		return nil, apperror.Unauthorized(apperror.ErrUserEmpty)
+	}
+
+	if _, err := m.empRepo.FindByUsername(ctx, username); err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, fwcontext.UsernameCtxKey, username)
+	ctx = setRequestUsername(ctx, username)
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,24 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+
+	"avito-tenders/pkg/fwcontext"
+	"avito-tenders/pkg/i18n"
+)
+
+// LanguageMiddleware negotiates response language by Accept-Language header. Negotiated language is stored
+// in the context and returned in Content-Language response header, so error responses are translated as well.
+func LanguageMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lang := i18n.Negotiate(r.Header.Get("Accept-Language"))
+
+		w.Header().Set("Content-Language", string(lang))
+		w.Header().Add("Vary", "Accept-Language")
+
+		ctx := context.WithValue(r.Context(), fwcontext.LanguageCtxKey, lang)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
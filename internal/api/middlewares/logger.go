@@ -0,0 +1,77 @@
+package middlewares
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel/trace"
+
+	"avito-tenders/pkg/fwcontext"
+)
+
+type accessLogCtxKey struct{}
+
+// accessLogEntry collects request attributes known only to inner handlers.
+type accessLogEntry struct {
+	username string
+}
+
+// LoggerMiddleware puts request-scoped logger into the context and writes access log entry when request is handled.
+func LoggerMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		attrs := []any{slog.String("requestId", middleware.GetReqID(r.Context()))}
+		if spanContext := trace.SpanContextFromContext(r.Context()); spanContext.HasTraceID() {
+			attrs = append(attrs, slog.String("traceId", spanContext.TraceID().String()))
+		}
+
+		logger := slog.Default().With(attrs...)
+		entry := &accessLogEntry{}
+
+		ctx := fwcontext.WithLogger(r.Context(), logger)
+		ctx = context.WithValue(ctx, accessLogCtxKey{}, entry)
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		next.ServeHTTP(ww, r.WithContext(ctx))
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		route := ""
+		if rctx := chi.RouteContext(r.Context()); rctx != nil {
+			route = rctx.RoutePattern()
+		}
+
+		level := slog.LevelInfo
+		if status >= http.StatusInternalServerError {
+			level = slog.LevelError
+		}
+
+		logger.Log(r.Context(), level, "request handled",
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.String("route", route),
+			slog.Int("status", status),
+			slog.Float64("latencyMs", float64(time.Since(start).Microseconds())/1000),
+			slog.Int("bytes", ww.BytesWritten()),
+			slog.String("username", entry.username),
+		)
+	})
+}
+
+// setRequestUsername adds username to the request-scoped logger and access log entry.
+func setRequestUsername(ctx context.Context, username string) context.Context {
+	if entry, ok := ctx.Value(accessLogCtxKey{}).(*accessLogEntry); ok {
+		entry.username = username
+	}
+
+	return fwcontext.WithLogger(ctx, fwcontext.GetLogger(ctx).With(slog.String("username", username)))
+}
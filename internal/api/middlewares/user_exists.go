@@ -27,8 +27,21 @@ func (mw *Manager) UserExistsMiddleware(next http.HandlerFunc) http.HandlerFunc
 		}
 
 		ctx := context.WithValue(r.Context(), fwcontext.UsernameCtxKey, username)
+		ctx = setRequestUsername(ctx, username)
 
 		// Pass the request to the next handler if the user exists
 		next.ServeHTTP(w, r.WithContext(ctx))
 	}
 }
+
+// OptionalUserMiddleware lets anonymous requests through, but checks the user if the username is given.
+func (mw *Manager) OptionalUserMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("username") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		mw.UserExistsMiddleware(next).ServeHTTP(w, r)
+	}
+}
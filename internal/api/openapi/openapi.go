@@ -0,0 +1,77 @@
+// Package openapi embeds the OpenAPI spec of the API and serves it with the Swagger UI page.
+package openapi
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"avito-tenders/pkg/fwcontext"
+)
+
+// swaggerUIVersion is the version of swagger-ui-dist loaded by the docs page.
+const swaggerUIVersion = "5.17.14"
+
+// Spec is the OpenAPI spec of the API in YAML.
+//
+//go:embed openapi.yml
+var Spec []byte
+
+// Load parses the embedded spec and checks that it is a valid OpenAPI document.
+func Load(ctx context.Context) (*openapi3.T, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(Spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI spec: %w", err)
+	}
+
+	if err := doc.Validate(ctx); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI spec: %w", err)
+	}
+
+	return doc, nil
+}
+
+// SpecHandler responds with the embedded spec.
+func SpecHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(Spec); err != nil {
+		fwcontext.GetLogger(r.Context()).Error("failed to send OpenAPI spec", "error", err)
+	}
+}
+
+// DocsHandler responds with the Swagger UI page for the spec served at specURL.
+func DocsHandler(specURL string) http.HandlerFunc {
+	page := []byte(fmt.Sprintf(docsPage, swaggerUIVersion, swaggerUIVersion, specURL))
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(page); err != nil {
+			fwcontext.GetLogger(r.Context()).Error("failed to send API docs page", "error", err)
+		}
+	}
+}
+
+const docsPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>Tender Management API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@%s/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@%s/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({url: %q, dom_id: "#swagger-ui"});
+    };
+  </script>
+</body>
+</html>
+`
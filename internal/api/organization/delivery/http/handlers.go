@@ -0,0 +1,126 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"avito-tenders/internal/api/organization"
+	"avito-tenders/internal/api/organization/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+type Handlers struct {
+	uc organization.Usecase
+}
+
+func NewHandlers(uc organization.Usecase) *Handlers {
+	return &Handlers{uc: uc}
+}
+
+func (h *Handlers) AddRelatedParty(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+		return
+	}
+
+	var partyBody dtos.RelatedPartyBody
+	if err := json.Unmarshal(body, &partyBody); err != nil {
+		apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+		return
+	}
+
+	request := dtos.AddRelatedPartyRequest{
+		OrganizationID:   chi.URLParam(r, organizationIDPathParam),
+		Username:         fwcontext.GetUsername(r.Context()),
+		RelatedPartyBody: partyBody,
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	party, err := h.uc.AddRelatedParty(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(party); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) RemoveRelatedParty(w http.ResponseWriter, r *http.Request) {
+	request := dtos.RemoveRelatedPartyRequest{
+		OrganizationID: chi.URLParam(r, organizationIDPathParam),
+		PartyType:      entity.AuthorType(chi.URLParam(r, partyTypePathParam)),
+		PartyID:        chi.URLParam(r, partyIDPathParam),
+		Username:       fwcontext.GetUsername(r.Context()),
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	if err := h.uc.RemoveRelatedParty(r.Context(), request); err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handlers) FindRelatedParties(w http.ResponseWriter, r *http.Request) {
+	request := dtos.FindRelatedPartiesRequest{
+		OrganizationID: chi.URLParam(r, organizationIDPathParam),
+		Username:       fwcontext.GetUsername(r.Context()),
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	parties, err := h.uc.FindRelatedParties(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(parties); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) FindConflictAttempts(w http.ResponseWriter, r *http.Request) {
+	request := dtos.FindConflictAttemptsRequest{
+		OrganizationID: chi.URLParam(r, organizationIDPathParam),
+		Username:       fwcontext.GetUsername(r.Context()),
+		Pagination:     fwcontext.GetPagination(r.Context()),
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	attempts, err := h.uc.FindConflictAttempts(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(attempts); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
@@ -0,0 +1,24 @@
+package http
+
+import (
+	"fmt"
+
+	"github.com/go-chi/chi/v5"
+
+	"avito-tenders/internal/api/middlewares"
+)
+
+const (
+	organizationIDPathParam = "organizationId"
+	partyTypePathParam      = "partyType"
+	partyIDPathParam        = "partyId"
+)
+
+func (h *Handlers) MapOrganizationsRoutes(r chi.Router, mw *middlewares.Manager) {
+	r.Route(fmt.Sprintf("/organizations/{%s}", organizationIDPathParam), func(r chi.Router) {
+		r.Get("/related_parties", middlewares.Conveyor(h.FindRelatedParties, mw.UserExistsMiddleware))
+		r.Put("/related_parties", middlewares.Conveyor(h.AddRelatedParty, mw.UserExistsMiddleware))
+		r.Delete(fmt.Sprintf("/related_parties/{%s}/{%s}", partyTypePathParam, partyIDPathParam), middlewares.Conveyor(h.RemoveRelatedParty, mw.UserExistsMiddleware))
+		r.Get("/conflict_attempts", middlewares.Conveyor(h.FindConflictAttempts, mw.UserExistsMiddleware, mw.PaginationMiddleware))
+	})
+}
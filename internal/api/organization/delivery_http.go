@@ -0,0 +1,10 @@
+package organization
+
+import "net/http"
+
+type HTTPHandlers interface {
+	AddRelatedParty(w http.ResponseWriter, r *http.Request)
+	RemoveRelatedParty(w http.ResponseWriter, r *http.Request)
+	FindRelatedParties(w http.ResponseWriter, r *http.Request)
+	FindConflictAttempts(w http.ResponseWriter, r *http.Request)
+}
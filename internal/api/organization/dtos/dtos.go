@@ -0,0 +1,124 @@
+package dtos
+
+import (
+	"github.com/invopop/validation"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/queryparams"
+	"avito-tenders/pkg/types"
+)
+
+type RelatedPartyBody struct {
+	PartyType entity.AuthorType `json:"partyType"`
+	PartyID   string            `json:"partyId"`
+}
+
+type AddRelatedPartyRequest struct {
+	OrganizationID string `json:"organizationId"`
+	Username       string `json:"username"`
+	RelatedPartyBody
+}
+
+func (r AddRelatedPartyRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.OrganizationID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required),
+		validation.Field(&r.PartyType, validation.Required, r.PartyType.ValidationRule()),
+		validation.Field(&r.PartyID, validation.Required, validation.Length(1, 100)))
+}
+
+func (r AddRelatedPartyRequest) ToEntity() entity.RelatedParty {
+	return entity.RelatedParty{
+		OrganizationID: r.OrganizationID,
+		PartyType:      r.PartyType,
+		PartyID:        r.PartyID,
+	}
+}
+
+type RemoveRelatedPartyRequest struct {
+	OrganizationID string            `json:"organizationId"`
+	PartyType      entity.AuthorType `json:"partyType"`
+	PartyID        string            `json:"partyId"`
+	Username       string            `json:"username"`
+}
+
+func (r RemoveRelatedPartyRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.OrganizationID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.PartyType, validation.Required, r.PartyType.ValidationRule()),
+		validation.Field(&r.PartyID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type FindRelatedPartiesRequest struct {
+	OrganizationID string `json:"organizationId"`
+	Username       string `json:"username"`
+}
+
+func (r FindRelatedPartiesRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.OrganizationID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type RelatedPartyResponse struct {
+	OrganizationID string            `json:"organizationId"`
+	PartyType      entity.AuthorType `json:"partyType"`
+	PartyID        string            `json:"partyId"`
+	CreatedAt      types.RFC3339Time `json:"createdAt"`
+}
+
+func NewRelatedPartyResponse(party entity.RelatedParty) RelatedPartyResponse {
+	return RelatedPartyResponse{
+		OrganizationID: party.OrganizationID,
+		PartyType:      party.PartyType,
+		PartyID:        party.PartyID,
+		CreatedAt:      types.RFCFromTime(party.CreatedAt),
+	}
+}
+
+func NewRelatedPartyResponseList(parties []entity.RelatedParty) []RelatedPartyResponse {
+	responses := make([]RelatedPartyResponse, 0, len(parties))
+	for _, party := range parties {
+		responses = append(responses, NewRelatedPartyResponse(party))
+	}
+
+	return responses
+}
+
+type FindConflictAttemptsRequest struct {
+	OrganizationID string `json:"organizationId"`
+	Username       string `json:"username"`
+	Pagination     queryparams.Pagination
+}
+
+func (r FindConflictAttemptsRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.OrganizationID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type ConflictAttemptResponse struct {
+	ID         string                `json:"id"`
+	TenderID   string                `json:"tenderId"`
+	AuthorType entity.AuthorType     `json:"authorType"`
+	AuthorID   string                `json:"authorId"`
+	Reason     entity.ConflictReason `json:"reason"`
+	CreatedAt  types.RFC3339Time     `json:"createdAt"`
+}
+
+func NewConflictAttemptResponseList(attempts []entity.ConflictAttempt) []ConflictAttemptResponse {
+	responses := make([]ConflictAttemptResponse, 0, len(attempts))
+	for _, attempt := range attempts {
+		responses = append(responses, ConflictAttemptResponse{
+			ID:         attempt.ID,
+			TenderID:   attempt.TenderID,
+			AuthorType: attempt.AuthorType,
+			AuthorID:   attempt.AuthorID,
+			Reason:     attempt.Reason,
+			CreatedAt:  types.RFCFromTime(attempt.CreatedAt),
+		})
+	}
+
+	return responses
+}
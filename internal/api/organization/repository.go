@@ -4,18 +4,46 @@ import (
 	"context"
 
 	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/queryparams"
 )
 
 type Repository interface {
 	// IsOrganizationResponsible checks if user is responsible in given organization.
 	IsOrganizationResponsible(ctx context.Context, organizationID, username string) (bool, error)
 
-	// GetUserOrganization returns user's organization.
-	GetUserOrganization(ctx context.Context, userID string) (entity.Organization, error)
+	// FindUserOrganizations returns organizations the user is responsible in.
+	FindUserOrganizations(ctx context.Context, userID string) ([]entity.Organization, error)
 
 	// GetOrganizationResponsible returns slice of responsible ids.
 	GetOrganizationResponsible(ctx context.Context, organizationID string) ([]string, error)
 
 	// FindByID returns organization found by organization id.
 	FindByID(ctx context.Context, organizationID string) (entity.Organization, error)
+
+	// FindByIDs returns organizations found by organization ids, missing ones are skipped.
+	FindByIDs(ctx context.Context, organizationIDs []string) ([]entity.Organization, error)
+
+	// FindResponsibles returns responsibilities in given organizations.
+	FindResponsibles(ctx context.Context, organizationIDs []string) ([]entity.OrganizationResponsible, error)
+
+	// FindUserResponsibilities returns responsibilities of given users.
+	FindUserResponsibilities(ctx context.Context, userIDs []string) ([]entity.OrganizationResponsible, error)
+
+	// AddRelatedParty declares the party related to the organization.
+	AddRelatedParty(ctx context.Context, party entity.RelatedParty) (entity.RelatedParty, error)
+
+	// RemoveRelatedParty removes declaration of the related party.
+	RemoveRelatedParty(ctx context.Context, organizationID string, partyType entity.AuthorType, partyID string) error
+
+	// FindRelatedParties returns parties related to the organization.
+	FindRelatedParties(ctx context.Context, organizationID string) ([]entity.RelatedParty, error)
+
+	// IsRelatedParty checks if the user or one of the user's organizations is related to the organization.
+	IsRelatedParty(ctx context.Context, organizationID, userID string, userOrganizationIDs []string) (bool, error)
+
+	// AddConflictAttempt logs the bid refused because of conflict of interest.
+	AddConflictAttempt(ctx context.Context, attempt entity.ConflictAttempt) error
+
+	// FindConflictAttempts returns bids refused on tenders of the organization.
+	FindConflictAttempts(ctx context.Context, organizationID string, pagination queryparams.Pagination) ([]entity.ConflictAttempt, error)
 }
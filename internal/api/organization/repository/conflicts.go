@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+	"avito-tenders/pkg/queryparams"
+)
+
+// AddRelatedParty declares the party related to the organization, declaring the same party again keeps the first
+// declaration.
+func (r Repository) AddRelatedParty(ctx context.Context, party entity.RelatedParty) (entity.RelatedParty, error) {
+	var added entity.RelatedParty
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx, `
+		insert into organization_related_parties (organization_id, party_type, party_id)
+		values ($1, $2, $3)
+		on conflict (organization_id, party_type, party_id) do update set
+		    created_at = organization_related_parties.created_at
+		returning organization_id, party_type, party_id, created_at
+`, party.OrganizationID, party.PartyType, party.PartyID).StructScan(&added)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't add related party", "error", err)
+		return entity.RelatedParty{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return added, nil
+}
+
+func (r Repository) RemoveRelatedParty(ctx context.Context, organizationID string, partyType entity.AuthorType, partyID string) error {
+	res, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, `
+		delete from organization_related_parties
+		where organization_id = $1 and party_type = $2 and party_id = $3
+`, organizationID, partyType, partyID)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't remove related party", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't get removed related parties", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
+	}
+	if affected == 0 {
+		return apperror.NotFound(apperror.ErrNotFound)
+	}
+
+	return nil
+}
+
+// FindRelatedParties returns parties related to the organization, the latest declared first.
+func (r Repository) FindRelatedParties(ctx context.Context, organizationID string) ([]entity.RelatedParty, error) {
+	parties := make([]entity.RelatedParty, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &parties, `
+		select organization_id, party_type, party_id, created_at from organization_related_parties
+		where organization_id = $1
+		order by created_at desc, party_id
+`, organizationID)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find related parties", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return parties, nil
+}
+
+func (r Repository) IsRelatedParty(ctx context.Context, organizationID, userID string, userOrganizationIDs []string) (bool, error) {
+	var related bool
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &related, `
+		select exists(
+		    select 1 from organization_related_parties
+		    where organization_id = $1
+		      and ((party_type = 'User' and party_id = $2)
+		        or (party_type = 'Organization' and party_id = any($3::uuid[])))
+		)
+`, organizationID, userID, pq.Array(userOrganizationIDs))
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't check related party", "error", err)
+		return false, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return related, nil
+}
+
+func (r Repository) AddConflictAttempt(ctx context.Context, attempt entity.ConflictAttempt) error {
+	_, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, `
+		insert into bid_conflict_attempts (tender_id, organization_id, author_type, author_id, reason)
+		values ($1, $2, $3, $4, $5)
+`, attempt.TenderID, attempt.OrganizationID, attempt.AuthorType, attempt.AuthorID, attempt.Reason)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't add conflict attempt", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return nil
+}
+
+// FindConflictAttempts returns bids refused on tenders of the organization, the latest first.
+func (r Repository) FindConflictAttempts(ctx context.Context, organizationID string, pagination queryparams.Pagination) ([]entity.ConflictAttempt, error) {
+	attempts := make([]entity.ConflictAttempt, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &attempts, `
+		select id, tender_id, organization_id, author_type, author_id, reason, created_at from bid_conflict_attempts
+		where organization_id = $1
+		order by created_at desc, id
+		limit $2 offset $3
+`, organizationID, pagination.Limit, pagination.Offset)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find conflict attempts", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return attempts, nil
+}
@@ -4,13 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"log/slog"
 
 	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"avito-tenders/internal/entity"
 	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
 )
 
 type Repository struct {
@@ -34,27 +35,22 @@ func (r Repository) GetOrganizationResponsible(ctx context.Context, organization
 	return responsibleList, nil
 }
 
-func (r Repository) GetUserOrganization(ctx context.Context, userID string) (entity.Organization, error) {
-	row := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx, `
-		select o.id, o.name, o.description, o.type, o.created_at, o.updated_at from organization_responsible r
-		                join organization o on o.id = r.organization_id
-		                where user_id = $1`, userID)
-	if row.Err() != nil {
-		return entity.Organization{}, apperror.Forbidden(apperror.ErrForbidden)
-	}
-
-	var org entity.Organization
-	if err := row.StructScan(&org); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return entity.Organization{}, apperror.Forbidden(apperror.ErrForbidden)
-		}
-
-		slog.Error("couldn't scan organization found by user id", "error", err)
+// FindUserOrganizations returns organizations the user is responsible in, ordered by name.
+func (r Repository) FindUserOrganizations(ctx context.Context, userID string) ([]entity.Organization, error) {
+	orgList := make([]entity.Organization, 0)
 
-		return entity.Organization{}, apperror.InternalServerError(apperror.ErrInternal)
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &orgList, `
+		select o.id, o.name, coalesce(o.description, '') as description, o.type, o.created_at, o.updated_at
+		from organization_responsible r
+		join organization o on o.id = r.organization_id
+		where r.user_id = $1
+		order by o.name, o.id`, userID)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find organizations by user id", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
 	}
 
-	return org, nil
+	return orgList, nil
 }
 
 func (r Repository) IsOrganizationResponsible(ctx context.Context, organizationID, username string) (bool, error) {
@@ -65,7 +61,7 @@ func (r Repository) IsOrganizationResponsible(ctx context.Context, organizationI
 `, username, organizationID)
 
 	if err := row.Err(); err != nil {
-		slog.Error("couldn't query is organization responsible", "error", err)
+		fwcontext.GetLogger(ctx).Error("couldn't query is organization responsible", "error", err)
 		return false, apperror.InternalServerError(apperror.ErrInternal)
 	}
 
@@ -75,7 +71,7 @@ func (r Repository) IsOrganizationResponsible(ctx context.Context, organizationI
 			return false, nil
 		}
 
-		slog.Error("couldn't scan organization responsible", "error", err)
+		fwcontext.GetLogger(ctx).Error("couldn't scan organization responsible", "error", err)
 
 		return false, apperror.InternalServerError(apperror.ErrInternal)
 	}
@@ -97,10 +93,52 @@ func (r Repository) FindByID(ctx context.Context, organizationID string) (entity
 			return entity.Organization{}, apperror.Unauthorized(apperror.ErrOrganizationDoesNotExist)
 		}
 
-		slog.Error("couldn't scan organization found by id", "error", err)
+		fwcontext.GetLogger(ctx).Error("couldn't scan organization found by id", "error", err)
 
 		return entity.Organization{}, apperror.InternalServerError(apperror.ErrInternal)
 	}
 
 	return org, nil
 }
+
+func (r Repository) FindByIDs(ctx context.Context, organizationIDs []string) ([]entity.Organization, error) {
+	orgList := make([]entity.Organization, 0, len(organizationIDs))
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &orgList, `
+		select id, name, coalesce(description, '') as description, type, created_at, updated_at from organization
+		where id = any($1::uuid[])`, pq.Array(organizationIDs))
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find organizations by ids", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return orgList, nil
+}
+
+func (r Repository) FindResponsibles(ctx context.Context, organizationIDs []string) ([]entity.OrganizationResponsible, error) {
+	responsibleList := make([]entity.OrganizationResponsible, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &responsibleList, `
+		select id, organization_id, user_id from organization_responsible
+		where organization_id = any($1::uuid[])`, pq.Array(organizationIDs))
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find responsibles by organization ids", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return responsibleList, nil
+}
+
+func (r Repository) FindUserResponsibilities(ctx context.Context, userIDs []string) ([]entity.OrganizationResponsible, error) {
+	responsibleList := make([]entity.OrganizationResponsible, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &responsibleList, `
+		select id, organization_id, user_id from organization_responsible
+		where user_id = any($1::uuid[])`, pq.Array(userIDs))
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find responsibilities by user ids", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return responsibleList, nil
+}
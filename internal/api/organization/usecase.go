@@ -0,0 +1,14 @@
+package organization
+
+import (
+	"context"
+
+	"avito-tenders/internal/api/organization/dtos"
+)
+
+type Usecase interface {
+	AddRelatedParty(ctx context.Context, req dtos.AddRelatedPartyRequest) (dtos.RelatedPartyResponse, error)
+	RemoveRelatedParty(ctx context.Context, req dtos.RemoveRelatedPartyRequest) error
+	FindRelatedParties(ctx context.Context, req dtos.FindRelatedPartiesRequest) ([]dtos.RelatedPartyResponse, error)
+	FindConflictAttempts(ctx context.Context, req dtos.FindConflictAttemptsRequest) ([]dtos.ConflictAttemptResponse, error)
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"avito-tenders/internal/api/organization"
+	"avito-tenders/internal/api/organization/dtos"
+	"avito-tenders/pkg/tracing"
+)
+
+// TracingUsecase wraps usecase to trace its calls.
+type TracingUsecase struct {
+	next organization.Usecase
+}
+
+func NewTracingUsecase(next organization.Usecase) *TracingUsecase {
+	return &TracingUsecase{next: next}
+}
+
+func (u TracingUsecase) AddRelatedParty(ctx context.Context, req dtos.AddRelatedPartyRequest) (dtos.RelatedPartyResponse, error) {
+	return tracing.Do(ctx, "organization.AddRelatedParty", func(ctx context.Context) (dtos.RelatedPartyResponse, error) {
+		return u.next.AddRelatedParty(ctx, req)
+	})
+}
+
+func (u TracingUsecase) RemoveRelatedParty(ctx context.Context, req dtos.RemoveRelatedPartyRequest) error {
+	return tracing.Run(ctx, "organization.RemoveRelatedParty", func(ctx context.Context) error {
+		return u.next.RemoveRelatedParty(ctx, req)
+	})
+}
+
+func (u TracingUsecase) FindRelatedParties(ctx context.Context, req dtos.FindRelatedPartiesRequest) ([]dtos.RelatedPartyResponse, error) {
+	return tracing.Do(ctx, "organization.FindRelatedParties", func(ctx context.Context) ([]dtos.RelatedPartyResponse, error) {
+		return u.next.FindRelatedParties(ctx, req)
+	})
+}
+
+func (u TracingUsecase) FindConflictAttempts(ctx context.Context, req dtos.FindConflictAttemptsRequest) ([]dtos.ConflictAttemptResponse, error) {
+	return tracing.Do(ctx, "organization.FindConflictAttempts", func(ctx context.Context) ([]dtos.ConflictAttemptResponse, error) {
+		return u.next.FindConflictAttempts(ctx, req)
+	})
+}
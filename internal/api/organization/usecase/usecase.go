@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"avito-tenders/internal/api/employee"
+	"avito-tenders/internal/api/organization"
+	"avito-tenders/internal/api/organization/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+)
+
+type Usecase struct {
+	repo    organization.Repository
+	empRepo employee.Repository
+}
+
+type Opts struct {
+	Repo    organization.Repository
+	EmpRepo employee.Repository
+}
+
+func NewUsecase(opts Opts) *Usecase {
+	return &Usecase{repo: opts.Repo, empRepo: opts.EmpRepo}
+}
+
+func (u Usecase) AddRelatedParty(ctx context.Context, req dtos.AddRelatedPartyRequest) (dtos.RelatedPartyResponse, error) {
+	if err := u.checkResponsible(ctx, req.OrganizationID, req.Username); err != nil {
+		return dtos.RelatedPartyResponse{}, err
+	}
+
+	if req.PartyType == entity.AuthorOrganization && req.PartyID == req.OrganizationID {
+		return dtos.RelatedPartyResponse{}, apperror.BadRequest(errors.New("organization can't be related to itself"))
+	}
+
+	if err := u.checkPartyExists(ctx, req.PartyType, req.PartyID); err != nil {
+		return dtos.RelatedPartyResponse{}, err
+	}
+
+	party, err := u.repo.AddRelatedParty(ctx, req.ToEntity())
+	if err != nil {
+		return dtos.RelatedPartyResponse{}, err
+	}
+
+	return dtos.NewRelatedPartyResponse(party), nil
+}
+
+func (u Usecase) RemoveRelatedParty(ctx context.Context, req dtos.RemoveRelatedPartyRequest) error {
+	if err := u.checkResponsible(ctx, req.OrganizationID, req.Username); err != nil {
+		return err
+	}
+
+	return u.repo.RemoveRelatedParty(ctx, req.OrganizationID, req.PartyType, req.PartyID)
+}
+
+func (u Usecase) FindRelatedParties(ctx context.Context, req dtos.FindRelatedPartiesRequest) ([]dtos.RelatedPartyResponse, error) {
+	if err := u.checkResponsible(ctx, req.OrganizationID, req.Username); err != nil {
+		return nil, err
+	}
+
+	parties, err := u.repo.FindRelatedParties(ctx, req.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dtos.NewRelatedPartyResponseList(parties), nil
+}
+
+func (u Usecase) FindConflictAttempts(ctx context.Context, req dtos.FindConflictAttemptsRequest) ([]dtos.ConflictAttemptResponse, error) {
+	if err := u.checkResponsible(ctx, req.OrganizationID, req.Username); err != nil {
+		return nil, err
+	}
+
+	attempts, err := u.repo.FindConflictAttempts(ctx, req.OrganizationID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return dtos.NewConflictAttemptResponseList(attempts), nil
+}
+
+// checkResponsible checks that the user is responsible in the organization, only responsibles manage its conflicts
+// of interest.
+func (u Usecase) checkResponsible(ctx context.Context, organizationID, username string) error {
+	isResponsible, err := u.repo.IsOrganizationResponsible(ctx, organizationID, username)
+	if err != nil {
+		return err
+	}
+	if !isResponsible {
+		return apperror.Forbidden(apperror.ErrForbidden)
+	}
+
+	return nil
+}
+
+func (u Usecase) checkPartyExists(ctx context.Context, partyType entity.AuthorType, partyID string) error {
+	var found int
+	switch partyType {
+	case entity.AuthorUser:
+		employees, err := u.empRepo.FindByIDs(ctx, []string{partyID})
+		if err != nil {
+			return err
+		}
+		found = len(employees)
+	case entity.AuthorOrganization:
+		organizations, err := u.repo.FindByIDs(ctx, []string{partyID})
+		if err != nil {
+			return err
+		}
+		found = len(organizations)
+	default:
+		return apperror.BadRequest(errors.New("party type is invalid"))
+	}
+
+	if found == 0 {
+		return apperror.NotFound(apperror.ErrNotFound)
+	}
+
+	return nil
+}
@@ -1,73 +1,86 @@
 package api
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 
-	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
-	trmcontext "github.com/avito-tech/go-transaction-manager/trm/v2/context"
-	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
 
+	attachmentsHttp "avito-tenders/internal/api/attachments/delivery/http"
 	bidsHttp "avito-tenders/internal/api/bids/delivery/http"
-	bidsRepo "avito-tenders/internal/api/bids/repository"
-	bidsUsecase "avito-tenders/internal/api/bids/usecase"
-	empRepo "avito-tenders/internal/api/employee/repository"
+	"avito-tenders/internal/api/graphql"
 	"avito-tenders/internal/api/middlewares"
-	orgRepo "avito-tenders/internal/api/organization/repository"
+	"avito-tenders/internal/api/openapi"
+	orgHttp "avito-tenders/internal/api/organization/delivery/http"
+	suppliersHttp "avito-tenders/internal/api/suppliers/delivery/http"
 	tendersHttp "avito-tenders/internal/api/tenders/delivery/http"
-	tendersRepo "avito-tenders/internal/api/tenders/repository"
-	tendersUsecase "avito-tenders/internal/api/tenders/usecase"
+	"avito-tenders/pkg/apperror"
 	"avito-tenders/pkg/backend"
+	"avito-tenders/pkg/health"
+	"avito-tenders/pkg/specvalidator"
 )
 
 const groupAPI = "/api"
 
 func InitAPIRoutes(b backend.Backend) (chi.Router, error) {
 	r := chi.NewRouter()
-	r.Use(middleware.Logger)
+	r.Use(middlewares.TracingMiddleware)
+	r.Use(middlewares.RequestIDMiddleware)
+	r.Use(middlewares.LoggerMiddleware)
+	r.Use(middlewares.LanguageMiddleware)
+	r.Use(middlewares.MetricsMiddleware)
 	r.Use(middleware.Recoverer)
 
 	r.Use(cors.Handler(cors.Options{
-		AllowedOrigins:   []string{"*"},
-		AllowedMethods:   []string{"GET"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedOrigins:   b.CORS.AllowedOrigins,
+		AllowedMethods:   b.CORS.AllowedMethods,
+		AllowedHeaders:   []string{"Accept", "Accept-Language", "Authorization", "Content-Type", "X-CSRF-Token", "X-Request-Id", "traceparent", "tracestate"},
+		ExposedHeaders:   []string{"Link", "X-Request-Id", "Content-Language"},
 		AllowCredentials: false,
 		MaxAge:           300,
 	}))
 
-	tendersRepository := tendersRepo.NewRepository(b.DB, trmsqlx.DefaultCtxGetter)
-	organizationRepository := orgRepo.NewRepository(b.DB, trmsqlx.DefaultCtxGetter)
-	bidsRepository := bidsRepo.NewRepository(b.DB, trmsqlx.DefaultCtxGetter)
-	empRepository := empRepo.NewRepository(b.DB, trmsqlx.DefaultCtxGetter)
+	if b.SpecViolations != nil {
+		doc, err := openapi.Load(context.Background())
+		if err != nil {
+			return nil, err
+		}
 
-	trManager := manager.Must(trmsqlx.NewDefaultFactory(b.DB), manager.WithCtxManager(trmcontext.DefaultManager))
+		validator, err := specvalidator.New(doc, b.SpecViolations)
+		if err != nil {
+			return nil, err
+		}
 
-	tendersUC := tendersUsecase.NewUsecase(tendersUsecase.Opts{
-		Repo:      tendersRepository,
-		OrgRepo:   organizationRepository,
-		TrManager: trManager,
-		EmpRepo:   empRepository,
-	})
-	bidsUC := bidsUsecase.NewUsecase(bidsUsecase.Opts{
-		Repo:       bidsRepository,
-		OrgRepo:    organizationRepository,
-		EmpRepo:    empRepository,
-		TenderRepo: tendersRepository,
-		TrManager:  trManager,
-	})
+		r.Use(validator.Middleware)
+	}
+
+	uc := newUsecases(b)
+	mwManager := middlewares.NewManager(uc.empRepo)
 
-	mwManager := middlewares.NewManager(empRepository)
+	tenderHandlers := tendersHttp.NewHandlers(uc.tenders)
+	bidsHandlers := bidsHttp.NewHandlers(uc.bids)
+	attachmentsHandlers := attachmentsHttp.NewHandlers(uc.attachments, b.AttachmentLimits.MaxSize)
+	suppliersHandlers := suppliersHttp.NewHandlers(uc.suppliers)
+	orgHandlers := orgHttp.NewHandlers(uc.orgs)
 
-	tenderHandlers := tendersHttp.NewHandlers(tendersUC)
-	bidsHandlers := bidsHttp.NewHandlers(bidsUC)
+	graphqlHandler, err := graphql.NewHandler(uc.repos)
+	if err != nil {
+		return nil, err
+	}
 
 	r.Route(groupAPI, func(r chi.Router) {
 		tenderHandlers.MapTendersRoutes(r, mwManager)
 		bidsHandlers.MapBidsRoutes(r, mwManager)
+		attachmentsHandlers.MapAttachmentsRoutes(r, mwManager)
+		suppliersHandlers.MapSuppliersRoutes(r, mwManager)
+		orgHandlers.MapOrganizationsRoutes(r, mwManager)
+		r.Post("/graphql", middlewares.Conveyor(graphqlHandler, mwManager.OptionalUserMiddleware))
+		r.Get("/errors", apperror.CatalogueHandler)
+		r.Get("/openapi.yml", openapi.SpecHandler)
+		r.Get("/docs", openapi.DocsHandler(groupAPI+"/openapi.yml"))
 		r.Get("/ping", func(w http.ResponseWriter, r *http.Request) {
 			err := b.DB.PingContext(r.Context())
 			if err != nil {
@@ -75,11 +88,22 @@ func InitAPIRoutes(b backend.Backend) (chi.Router, error) {
 				return
 			}
 
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
 			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write([]byte("ok")); err != nil {
+				slog.Error("failed to send ping response", "error", err)
+			}
 		})
 	})
 
+	// Probes are mounted outside of API middlewares, so they do not pollute access log, metrics and traces.
+	root := chi.NewRouter()
+	root.Get("/healthz", health.LivenessHandler)
+	root.Get("/readyz", b.Health.ReadinessHandler)
+	root.Get("/health", b.Health.ReportHandler)
+	root.Mount("/", r)
+
 	slog.Info("API routes initialized")
 
-	return r, nil
+	return root, nil
 }
@@ -0,0 +1,42 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"avito-tenders/internal/api/suppliers"
+	"avito-tenders/internal/api/suppliers/dtos"
+	"avito-tenders/pkg/apperror"
+)
+
+type Handlers struct {
+	uc suppliers.Usecase
+}
+
+func NewHandlers(uc suppliers.Usecase) *Handlers {
+	return &Handlers{uc: uc}
+}
+
+func (h *Handlers) GetReputation(w http.ResponseWriter, r *http.Request) {
+	request := dtos.GetReputationRequest{
+		SupplierID: chi.URLParam(r, supplierIDPathParam),
+	}
+	if err := request.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	reputation, err := h.uc.GetReputation(r.Context(), request)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(reputation); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
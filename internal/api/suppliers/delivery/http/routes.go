@@ -0,0 +1,17 @@
+package http
+
+import (
+	"fmt"
+
+	"github.com/go-chi/chi/v5"
+
+	"avito-tenders/internal/api/middlewares"
+)
+
+const supplierIDPathParam = "supplierId"
+
+func (h *Handlers) MapSuppliersRoutes(r chi.Router, mw *middlewares.Manager) {
+	r.Route("/suppliers", func(r chi.Router) {
+		r.Get(fmt.Sprintf("/{%s}/reputation", supplierIDPathParam), middlewares.Conveyor(h.GetReputation, mw.UserExistsMiddleware))
+	})
+}
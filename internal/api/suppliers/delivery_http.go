@@ -0,0 +1,7 @@
+package suppliers
+
+import "net/http"
+
+type HTTPHandlers interface {
+	GetReputation(w http.ResponseWriter, r *http.Request)
+}
@@ -0,0 +1,48 @@
+package dtos
+
+import (
+	"github.com/invopop/validation"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/types"
+)
+
+type GetReputationRequest struct {
+	SupplierID string
+}
+
+func (r GetReputationRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.SupplierID, validation.Required, validation.Length(1, 100)))
+}
+
+type ReputationResponse struct {
+	SupplierID    string             `json:"supplierId"`
+	SupplierType  entity.AuthorType  `json:"supplierType"`
+	ReviewsCount  int                `json:"reviewsCount"`
+	RatingsCount  int                `json:"ratingsCount"`
+	Rating        *float64           `json:"rating,omitempty"`
+	Quality       *float64           `json:"quality,omitempty"`
+	Timeliness    *float64           `json:"timeliness,omitempty"`
+	Communication *float64           `json:"communication,omitempty"`
+	UpdatedAt     *types.RFC3339Time `json:"updatedAt,omitempty"`
+}
+
+func NewReputationResponse(reputation entity.Reputation) ReputationResponse {
+	response := ReputationResponse{
+		SupplierID:    reputation.SupplierID,
+		SupplierType:  reputation.SupplierType,
+		ReviewsCount:  reputation.ReviewsCount,
+		RatingsCount:  reputation.RatingsCount,
+		Rating:        reputation.Rating,
+		Quality:       reputation.Quality,
+		Timeliness:    reputation.Timeliness,
+		Communication: reputation.Communication,
+	}
+	if reputation.UpdatedAt != nil {
+		updatedAt := types.RFCFromTime(*reputation.UpdatedAt)
+		response.UpdatedAt = &updatedAt
+	}
+
+	return response
+}
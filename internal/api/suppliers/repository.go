@@ -0,0 +1,21 @@
+package suppliers
+
+import (
+	"context"
+
+	"avito-tenders/internal/entity"
+)
+
+type Repository interface {
+	// AddReview accounts the review on the bid in reputation of the bid supplier.
+	AddReview(ctx context.Context, bidID string, ratings entity.ReviewRatings) error
+
+	// RemoveReview discounts the review on the bid from reputation of the bid supplier.
+	RemoveReview(ctx context.Context, bidID string, ratings entity.ReviewRatings) error
+
+	// FindByID returns reputation of the user or the organization, it is empty if the supplier has no reviews.
+	FindByID(ctx context.Context, supplierID string) (entity.Reputation, error)
+
+	// FindByBidIDs returns reputation of the bid suppliers by bid ids, bids of suppliers without reviews are omitted.
+	FindByBidIDs(ctx context.Context, bidIDs []string) (map[string]entity.Reputation, error)
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+// supplierOfBid selects supplier of the bid `b`: its author for user bids and the organization the bid is made
+// on behalf of for organization bids.
+const supplierOfBid = `
+		case
+		    when b.author_type = 'Organization' then b.organization_id
+		    else b.author_id
+		end`
+
+// averageRatings selects average ratings of supplier reputation `sr`, they are null without marks.
+const averageRatings = `
+		round(sr.rating_sum::numeric / nullif(sr.rating_count, 0), 2) as rating,
+		round(sr.quality_sum::numeric / nullif(sr.quality_count, 0), 2) as quality,
+		round(sr.timeliness_sum::numeric / nullif(sr.timeliness_count, 0), 2) as timeliness,
+		round(sr.communication_sum::numeric / nullif(sr.communication_count, 0), 2) as communication`
+
+type Repository struct {
+	db     *sqlx.DB
+	getter *trmsqlx.CtxGetter
+}
+
+func NewRepository(db *sqlx.DB, getter *trmsqlx.CtxGetter) *Repository {
+	return &Repository{db: db, getter: getter}
+}
+
+func (r Repository) AddReview(ctx context.Context, bidID string, ratings entity.ReviewRatings) error {
+	_, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, `
+		insert into supplier_reputation as sr(supplier_id, supplier_type, reviews_count, rating_count, rating_sum,
+		                                      quality_count, quality_sum, timeliness_count, timeliness_sum,
+		                                      communication_count, communication_sum)
+		select s.supplier_id, s.supplier_type, 1,
+		       ($2::int is not null)::int, coalesce($2::int, 0),
+		       ($3::int is not null)::int, coalesce($3::int, 0),
+		       ($4::int is not null)::int, coalesce($4::int, 0),
+		       ($5::int is not null)::int, coalesce($5::int, 0)
+		from (select `+supplierOfBid+` as supplier_id, b.author_type as supplier_type from bids b where b.id = $1) s
+		where s.supplier_id is not null
+		on conflict (supplier_id) do update set
+		    reviews_count = sr.reviews_count + excluded.reviews_count,
+		    rating_count = sr.rating_count + excluded.rating_count,
+		    rating_sum = sr.rating_sum + excluded.rating_sum,
+		    quality_count = sr.quality_count + excluded.quality_count,
+		    quality_sum = sr.quality_sum + excluded.quality_sum,
+		    timeliness_count = sr.timeliness_count + excluded.timeliness_count,
+		    timeliness_sum = sr.timeliness_sum + excluded.timeliness_sum,
+		    communication_count = sr.communication_count + excluded.communication_count,
+		    communication_sum = sr.communication_sum + excluded.communication_sum,
+		    updated_at = now()
+`, bidID, ratings.Rating, ratings.Quality, ratings.Timeliness, ratings.Communication)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't add review to supplier reputation", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return nil
+}
+
+// RemoveReview discounts the review on the bid from reputation of the bid supplier, it is the reverse of AddReview.
+func (r Repository) RemoveReview(ctx context.Context, bidID string, ratings entity.ReviewRatings) error {
+	_, err := r.getter.DefaultTrOrDB(ctx, r.db).ExecContext(ctx, `
+		update supplier_reputation sr set
+		    reviews_count = sr.reviews_count - 1,
+		    rating_count = sr.rating_count - ($2::int is not null)::int,
+		    rating_sum = sr.rating_sum - coalesce($2::int, 0),
+		    quality_count = sr.quality_count - ($3::int is not null)::int,
+		    quality_sum = sr.quality_sum - coalesce($3::int, 0),
+		    timeliness_count = sr.timeliness_count - ($4::int is not null)::int,
+		    timeliness_sum = sr.timeliness_sum - coalesce($4::int, 0),
+		    communication_count = sr.communication_count - ($5::int is not null)::int,
+		    communication_sum = sr.communication_sum - coalesce($5::int, 0),
+		    updated_at = now()
+		from bids b
+		where b.id = $1 and sr.supplier_id = `+supplierOfBid+`
+`, bidID, ratings.Rating, ratings.Quality, ratings.Timeliness, ratings.Communication)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't remove review from supplier reputation", "error", err)
+		return apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return nil
+}
+
+func (r Repository) FindByID(ctx context.Context, supplierID string) (entity.Reputation, error) {
+	row := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx, `
+		select s.id as supplier_id, s.type as supplier_type,
+		       coalesce(sr.reviews_count, 0) as reviews_count, coalesce(sr.rating_count, 0) as rating_count,
+		       sr.updated_at,`+averageRatings+`
+		from (select id, 'User' as type from employee where id = $1
+		      union all
+		      select id, 'Organization' as type from organization where id = $1) s
+		left join supplier_reputation sr on sr.supplier_id = s.id
+		limit 1`, supplierID)
+	if row.Err() != nil {
+		return entity.Reputation{}, apperror.BadRequest(apperror.ErrInvalidInput)
+	}
+
+	var reputation entity.Reputation
+	if err := row.StructScan(&reputation); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Reputation{}, apperror.NotFound(apperror.ErrNotFound)
+		}
+
+		fwcontext.GetLogger(ctx).Error("couldn't scan supplier reputation", "error", err)
+
+		return entity.Reputation{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return reputation, nil
+}
+
+func (r Repository) FindByBidIDs(ctx context.Context, bidIDs []string) (map[string]entity.Reputation, error) {
+	var rows []struct {
+		BidID string `db:"bid_id"`
+		entity.Reputation
+	}
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &rows, `
+		select b.id as bid_id, sr.supplier_id, sr.supplier_type, sr.reviews_count, sr.rating_count, sr.updated_at,`+averageRatings+`
+		from bids b
+		join supplier_reputation sr on sr.supplier_id = `+supplierOfBid+`
+		where b.id = any($1::uuid[])
+`, pq.Array(bidIDs))
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("couldn't find supplier reputation by bid ids", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	reputations := make(map[string]entity.Reputation, len(rows))
+	for _, row := range rows {
+		reputations[row.BidID] = row.Reputation
+	}
+
+	return reputations, nil
+}
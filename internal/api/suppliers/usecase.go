@@ -0,0 +1,11 @@
+package suppliers
+
+import (
+	"context"
+
+	"avito-tenders/internal/api/suppliers/dtos"
+)
+
+type Usecase interface {
+	GetReputation(ctx context.Context, req dtos.GetReputationRequest) (dtos.ReputationResponse, error)
+}
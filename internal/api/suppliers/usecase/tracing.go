@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"avito-tenders/internal/api/suppliers"
+	"avito-tenders/internal/api/suppliers/dtos"
+	"avito-tenders/pkg/tracing"
+)
+
+// TracingUsecase wraps usecase to trace its calls.
+type TracingUsecase struct {
+	next suppliers.Usecase
+}
+
+func NewTracingUsecase(next suppliers.Usecase) *TracingUsecase {
+	return &TracingUsecase{next: next}
+}
+
+func (u TracingUsecase) GetReputation(ctx context.Context, req dtos.GetReputationRequest) (dtos.ReputationResponse, error) {
+	return tracing.Do(ctx, "suppliers.GetReputation", func(ctx context.Context) (dtos.ReputationResponse, error) {
+		return u.next.GetReputation(ctx, req)
+	})
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"avito-tenders/internal/api/suppliers"
+	"avito-tenders/internal/api/suppliers/dtos"
+)
+
+type Usecase struct {
+	repo suppliers.Repository
+}
+
+type Opts struct {
+	Repo suppliers.Repository
+}
+
+func NewUsecase(opts Opts) *Usecase {
+	return &Usecase{repo: opts.Repo}
+}
+
+func (u Usecase) GetReputation(ctx context.Context, req dtos.GetReputationRequest) (dtos.ReputationResponse, error) {
+	reputation, err := u.repo.FindByID(ctx, req.SupplierID)
+	if err != nil {
+		return dtos.ReputationResponse{}, err
+	}
+
+	return dtos.NewReputationResponse(reputation), nil
+}
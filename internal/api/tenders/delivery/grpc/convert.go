@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/internal/entity"
+	tendersv1 "avito-tenders/pkg/pb/tenders/v1"
+)
+
+var errTenderIDEmpty = errors.New("tender id is not specified")
+
+var tenderStatuses = map[tendersv1.TenderStatus]entity.TenderStatus{
+	tendersv1.TenderStatus_TENDER_STATUS_CREATED:   entity.TenderCreated,
+	tendersv1.TenderStatus_TENDER_STATUS_PUBLISHED: entity.TenderPublished,
+	tendersv1.TenderStatus_TENDER_STATUS_CLOSED:    entity.TenderClosed,
+}
+
+var serviceTypes = map[tendersv1.ServiceType]entity.ServiceType{
+	tendersv1.ServiceType_SERVICE_TYPE_CONSTRUCTION: entity.ServiceConstruction,
+	tendersv1.ServiceType_SERVICE_TYPE_DELIVERY:     entity.ServiceDelivery,
+	tendersv1.ServiceType_SERVICE_TYPE_MANUFACTURE:  entity.ServiceManufacture,
+}
+
+// tenderStatusFromProto returns empty status for unspecified and unknown values, so they fail validation.
+func tenderStatusFromProto(status tendersv1.TenderStatus) entity.TenderStatus {
+	return tenderStatuses[status]
+}
+
+func tenderStatusToProto(status entity.TenderStatus) tendersv1.TenderStatus {
+	for protoStatus, entityStatus := range tenderStatuses {
+		if entityStatus == status {
+			return protoStatus
+		}
+	}
+
+	return tendersv1.TenderStatus_TENDER_STATUS_UNSPECIFIED
+}
+
+// serviceTypeFromProto returns empty service type for unspecified and unknown values, so they fail validation.
+func serviceTypeFromProto(serviceType tendersv1.ServiceType) entity.ServiceType {
+	return serviceTypes[serviceType]
+}
+
+func serviceTypeToProto(serviceType entity.ServiceType) tendersv1.ServiceType {
+	for protoServiceType, entityServiceType := range serviceTypes {
+		if entityServiceType == serviceType {
+			return protoServiceType
+		}
+	}
+
+	return tendersv1.ServiceType_SERVICE_TYPE_UNSPECIFIED
+}
+
+func tenderToProto(tender dtos.TenderResponse) *tendersv1.Tender {
+	return &tendersv1.Tender{
+		Id:             tender.ID,
+		Name:           tender.Name,
+		Description:    tender.Description,
+		ServiceType:    serviceTypeToProto(tender.ServiceType),
+		Status:         tenderStatusToProto(tender.Status),
+		OrganizationId: tender.OrganizationID,
+		Version:        int32(tender.Version),
+		CreatedAt:      timestamppb.New(time.Time(tender.CreatedAt)),
+	}
+}
+
+func tendersToProto(tendersList []dtos.TenderResponse) []*tendersv1.Tender {
+	result := make([]*tendersv1.Tender, 0, len(tendersList))
+	for i := range tendersList {
+		result = append(result, tenderToProto(tendersList[i]))
+	}
+
+	return result
+}
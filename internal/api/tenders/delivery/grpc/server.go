@@ -0,0 +1,177 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/invopop/validation"
+	"google.golang.org/grpc"
+
+	"avito-tenders/internal/api/tenders"
+	"avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+	tendersv1 "avito-tenders/pkg/pb/tenders/v1"
+	"avito-tenders/pkg/queryparams"
+)
+
+// PublicMethods may be called without username, like HTTP routes without UserExistsMiddleware.
+var PublicMethods = []string{
+	tendersv1.TenderService_ListTenders_FullMethodName,
+	tendersv1.TenderService_CreateTender_FullMethodName,
+	tendersv1.TenderService_GetTenderStatus_FullMethodName,
+}
+
+type Server struct {
+	tendersv1.UnimplementedTenderServiceServer
+
+	uc tenders.Usecase
+}
+
+func NewServer(uc tenders.Usecase) *Server {
+	return &Server{uc: uc}
+}
+
+func (s *Server) RegisterTenderService(r grpc.ServiceRegistrar) {
+	tendersv1.RegisterTenderServiceServer(r, s)
+}
+
+func (s *Server) ListTenders(ctx context.Context, req *tendersv1.ListTendersRequest) (*tendersv1.ListTendersResponse, error) {
+	pagination, err := queryparams.NewPagination(req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := tenders.TenderFilter{}
+	for _, protoServiceType := range req.GetServiceTypes() {
+		serviceType := serviceTypeFromProto(protoServiceType)
+		if err := validation.Validate(serviceType, validation.Required, serviceType.ValidationRule()); err != nil {
+			return nil, apperror.BadRequest(err)
+		}
+
+		filter.ServiceTypes = append(filter.ServiceTypes, serviceType)
+	}
+
+	tendersList, err := s.uc.GetAll(ctx, filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tendersv1.ListTendersResponse{Tenders: tendersToProto(tendersList)}, nil
+}
+
+func (s *Server) CreateTender(ctx context.Context, req *tendersv1.CreateTenderRequest) (*tendersv1.Tender, error) {
+	request := dtos.CreateTenderRequest{
+		Name:            req.GetName(),
+		Description:     req.GetDescription(),
+		ServiceType:     serviceTypeFromProto(req.GetServiceType()),
+		Status:          tenderStatusFromProto(req.GetStatus()),
+		OrganizationID:  req.GetOrganizationId(),
+		CreatorUsername: req.GetCreatorUsername(),
+	}
+	if err := request.Validate(); err != nil {
+		return nil, apperror.BadRequest(err)
+	}
+
+	tender, err := s.uc.Create(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return tenderToProto(tender), nil
+}
+
+func (s *Server) ListMyTenders(ctx context.Context, req *tendersv1.ListMyTendersRequest) (*tendersv1.ListTendersResponse, error) {
+	pagination, err := queryparams.NewPagination(req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	tendersList, err := s.uc.FindByUsername(ctx, fwcontext.GetUsername(ctx), pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tendersv1.ListTendersResponse{Tenders: tendersToProto(tendersList)}, nil
+}
+
+func (s *Server) GetTenderStatus(ctx context.Context, req *tendersv1.GetTenderStatusRequest) (*tendersv1.GetTenderStatusResponse, error) {
+	if req.GetTenderId() == "" {
+		return nil, apperror.BadRequest(errTenderIDEmpty)
+	}
+
+	tender, err := s.uc.GetTenderStatus(ctx, req.GetTenderId(), dtos.TenderStatus{
+		Username: fwcontext.GetUsername(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tendersv1.GetTenderStatusResponse{Status: tenderStatusToProto(tender.Status)}, nil
+}
+
+func (s *Server) UpdateTenderStatus(ctx context.Context, req *tendersv1.UpdateTenderStatusRequest) (*tendersv1.Tender, error) {
+	if req.GetTenderId() == "" {
+		return nil, apperror.BadRequest(errTenderIDEmpty)
+	}
+
+	request := dtos.EditTenderStatusRequest{
+		Status:   tenderStatusFromProto(req.GetStatus()),
+		Username: fwcontext.GetUsername(ctx),
+	}
+	if err := request.Validate(); err != nil {
+		return nil, apperror.BadRequest(err)
+	}
+
+	tender, err := s.uc.EditStatus(ctx, req.GetTenderId(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	return tenderToProto(tender), nil
+}
+
+func (s *Server) EditTender(ctx context.Context, req *tendersv1.EditTenderRequest) (*tendersv1.Tender, error) {
+	if req.GetTenderId() == "" {
+		return nil, apperror.BadRequest(errTenderIDEmpty)
+	}
+
+	request := dtos.EditTenderRequest{
+		EditTender: dtos.EditTender{
+			Name:        req.GetName(),
+			Description: req.GetDescription(),
+			ServiceType: serviceTypeFromProto(req.GetServiceType()),
+		},
+		Username: fwcontext.GetUsername(ctx),
+	}
+	if err := request.Validate(); err != nil {
+		return nil, apperror.BadRequest(err)
+	}
+
+	tender, err := s.uc.Edit(ctx, req.GetTenderId(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	return tenderToProto(tender), nil
+}
+
+func (s *Server) RollbackTender(ctx context.Context, req *tendersv1.RollbackTenderRequest) (*tendersv1.Tender, error) {
+	if req.GetTenderId() == "" {
+		return nil, apperror.BadRequest(errTenderIDEmpty)
+	}
+
+	request := dtos.RollbackTenderRequest{
+		Username: fwcontext.GetUsername(ctx),
+		Version:  int(req.GetVersion()),
+	}
+	if err := request.Validate(); err != nil {
+		return nil, apperror.BadRequest(err)
+	}
+
+	tender, err := s.uc.Rollback(ctx, req.GetTenderId(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	return tenderToProto(tender), nil
+}
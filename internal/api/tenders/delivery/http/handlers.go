@@ -126,7 +126,7 @@ func (h *Handlers) GetTenderStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
 
 	if _, err = w.Write([]byte(tender.Status)); err != nil {
@@ -0,0 +1,134 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+func (h *Handlers) CreateItem(w http.ResponseWriter, r *http.Request) {
+	tenderID := chi.URLParam(r, tenderIDPathParam)
+	if tenderID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("tender id is not specified")))
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+		return
+	}
+
+	var itemBody dtos.CreateItemBody
+	if err := json.Unmarshal(body, &itemBody); err != nil {
+		apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+		return
+	}
+
+	req := dtos.CreateItemRequest{
+		TenderID:       tenderID,
+		Username:       fwcontext.GetUsername(r.Context()),
+		CreateItemBody: itemBody,
+	}
+	if err := req.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	item, err := h.uc.CreateItem(r.Context(), req)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(item); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) EditItem(w http.ResponseWriter, r *http.Request) {
+	tenderID := chi.URLParam(r, tenderIDPathParam)
+	if tenderID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("tender id is not specified")))
+		return
+	}
+
+	itemID := chi.URLParam(r, itemIDPathParam)
+	if itemID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("item id is not specified")))
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+		return
+	}
+
+	var itemBody dtos.EditItemBody
+	if err := json.Unmarshal(body, &itemBody); err != nil {
+		apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+		return
+	}
+
+	req := dtos.EditItemRequest{
+		TenderID:     tenderID,
+		ItemID:       itemID,
+		Username:     fwcontext.GetUsername(r.Context()),
+		EditItemBody: itemBody,
+	}
+	if err := req.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	item, err := h.uc.EditItem(r.Context(), req)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(item); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) GetItems(w http.ResponseWriter, r *http.Request) {
+	tenderID := chi.URLParam(r, tenderIDPathParam)
+	if tenderID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("tender id is not specified")))
+		return
+	}
+
+	req := dtos.FindItemsRequest{
+		TenderID: tenderID,
+		Username: r.URL.Query().Get("username"),
+	}
+	if err := req.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	items, err := h.uc.FindItems(r.Context(), req)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(items); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
@@ -0,0 +1,220 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+func (h *Handlers) CreateLot(w http.ResponseWriter, r *http.Request) {
+	tenderID := chi.URLParam(r, tenderIDPathParam)
+	if tenderID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("tender id is not specified")))
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+		return
+	}
+
+	var lotBody dtos.CreateLotBody
+	if err := json.Unmarshal(body, &lotBody); err != nil {
+		apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+		return
+	}
+
+	req := dtos.CreateLotRequest{
+		TenderID:      tenderID,
+		Username:      fwcontext.GetUsername(r.Context()),
+		CreateLotBody: lotBody,
+	}
+	if err := req.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	lot, err := h.uc.CreateLot(r.Context(), req)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(lot); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) GetLots(w http.ResponseWriter, r *http.Request) {
+	tenderID := chi.URLParam(r, tenderIDPathParam)
+	if tenderID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("tender id is not specified")))
+		return
+	}
+
+	req := dtos.FindLotsRequest{
+		TenderID: tenderID,
+		Username: r.URL.Query().Get("username"),
+	}
+	if err := req.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	lots, err := h.uc.FindLots(r.Context(), req)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(lots); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) EditLot(w http.ResponseWriter, r *http.Request) {
+	tenderID := chi.URLParam(r, tenderIDPathParam)
+	if tenderID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("tender id is not specified")))
+		return
+	}
+
+	lotID := chi.URLParam(r, lotIDPathParam)
+	if lotID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("lot id is not specified")))
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+		return
+	}
+
+	var lotBody dtos.EditLotBody
+	if err := json.Unmarshal(body, &lotBody); err != nil {
+		apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+		return
+	}
+
+	req := dtos.EditLotRequest{
+		TenderID:    tenderID,
+		LotID:       lotID,
+		Username:    fwcontext.GetUsername(r.Context()),
+		EditLotBody: lotBody,
+	}
+	if err := req.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	lot, err := h.uc.EditLot(r.Context(), req)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(lot); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) CancelLot(w http.ResponseWriter, r *http.Request) {
+	tenderID := chi.URLParam(r, tenderIDPathParam)
+	if tenderID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("tender id is not specified")))
+		return
+	}
+
+	lotID := chi.URLParam(r, lotIDPathParam)
+	if lotID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("lot id is not specified")))
+		return
+	}
+
+	req := dtos.CancelLotRequest{
+		TenderID: tenderID,
+		LotID:    lotID,
+		Username: fwcontext.GetUsername(r.Context()),
+	}
+	if err := req.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	lot, err := h.uc.CancelLot(r.Context(), req)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(lot); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) RollbackLot(w http.ResponseWriter, r *http.Request) {
+	tenderID := chi.URLParam(r, tenderIDPathParam)
+	if tenderID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("tender id is not specified")))
+		return
+	}
+
+	lotID := chi.URLParam(r, lotIDPathParam)
+	if lotID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("lot id is not specified")))
+		return
+	}
+
+	version := chi.URLParam(r, versionPathParam)
+	if version == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("version is not specified")))
+		return
+	}
+
+	intVersion, err := strconv.Atoi(version)
+	if err != nil {
+		apperror.SendError(w, apperror.BadRequest(errors.New("version is not a number")))
+		return
+	}
+
+	req := dtos.RollbackLotRequest{
+		TenderID: tenderID,
+		LotID:    lotID,
+		Version:  intVersion,
+		Username: fwcontext.GetUsername(r.Context()),
+	}
+	if err := req.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	lot, err := h.uc.RollbackLot(r.Context(), req)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(lot); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
@@ -11,6 +11,8 @@ import (
 const (
 	tenderIDPathParam = "tenderId"
 	versionPathParam  = "version"
+	lotIDPathParam    = "lotId"
+	itemIDPathParam   = "itemId"
 )
 
 func (h *Handlers) MapTendersRoutes(r chi.Router, mw *middlewares.Manager) {
@@ -22,5 +24,18 @@ func (h *Handlers) MapTendersRoutes(r chi.Router, mw *middlewares.Manager) {
 		r.Put(fmt.Sprintf("/{%s}/status", tenderIDPathParam), middlewares.Conveyor(h.UpdateTenderStatus, mw.UserExistsMiddleware))
 		r.Patch(fmt.Sprintf("/{%s}/edit", tenderIDPathParam), middlewares.Conveyor(h.UpdateTender, mw.UserExistsMiddleware))
 		r.Put(fmt.Sprintf("/{%s}/rollback/{%s}", tenderIDPathParam, versionPathParam), middlewares.Conveyor(h.RollbackTender, mw.UserExistsMiddleware))
+
+		r.Get(fmt.Sprintf("/{%s}/lots", tenderIDPathParam), h.GetLots)
+		r.Post(fmt.Sprintf("/{%s}/lots/new", tenderIDPathParam), middlewares.Conveyor(h.CreateLot, mw.UserExistsMiddleware))
+		r.Patch(fmt.Sprintf("/{%s}/lots/{%s}/edit", tenderIDPathParam, lotIDPathParam), middlewares.Conveyor(h.EditLot, mw.UserExistsMiddleware))
+		r.Put(fmt.Sprintf("/{%s}/lots/{%s}/cancel", tenderIDPathParam, lotIDPathParam), middlewares.Conveyor(h.CancelLot, mw.UserExistsMiddleware))
+		r.Put(fmt.Sprintf("/{%s}/lots/{%s}/rollback/{%s}", tenderIDPathParam, lotIDPathParam, versionPathParam), middlewares.Conveyor(h.RollbackLot, mw.UserExistsMiddleware))
+
+		r.Get(fmt.Sprintf("/{%s}/items", tenderIDPathParam), h.GetItems)
+		r.Post(fmt.Sprintf("/{%s}/items/new", tenderIDPathParam), middlewares.Conveyor(h.CreateItem, mw.UserExistsMiddleware))
+		r.Patch(fmt.Sprintf("/{%s}/items/{%s}/edit", tenderIDPathParam, itemIDPathParam), middlewares.Conveyor(h.EditItem, mw.UserExistsMiddleware))
+
+		r.Get(fmt.Sprintf("/{%s}/award_settings", tenderIDPathParam), middlewares.Conveyor(h.GetAwardSettings, mw.UserExistsMiddleware))
+		r.Put(fmt.Sprintf("/{%s}/award_settings", tenderIDPathParam), middlewares.Conveyor(h.SetAwardSettings, mw.UserExistsMiddleware))
 	})
 }
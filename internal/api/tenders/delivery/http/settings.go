@@ -0,0 +1,85 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+func (h *Handlers) SetAwardSettings(w http.ResponseWriter, r *http.Request) {
+	tenderID := chi.URLParam(r, tenderIDPathParam)
+	if tenderID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("tender id is not specified")))
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+		return
+	}
+
+	var settingsBody dtos.AwardSettingsBody
+	if err := json.Unmarshal(body, &settingsBody); err != nil {
+		apperror.SendError(w, apperror.BadRequest(apperror.ErrInvalidInput))
+		return
+	}
+
+	req := dtos.SetAwardSettingsRequest{
+		TenderID:          tenderID,
+		Username:          fwcontext.GetUsername(r.Context()),
+		AwardSettingsBody: settingsBody,
+	}
+	if err := req.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	settings, err := h.uc.SetAwardSettings(r.Context(), req)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(settings); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
+
+func (h *Handlers) GetAwardSettings(w http.ResponseWriter, r *http.Request) {
+	tenderID := chi.URLParam(r, tenderIDPathParam)
+	if tenderID == "" {
+		apperror.SendError(w, apperror.BadRequest(errors.New("tender id is not specified")))
+		return
+	}
+
+	req := dtos.FindAwardSettingsRequest{
+		TenderID: tenderID,
+		Username: fwcontext.GetUsername(r.Context()),
+	}
+	if err := req.Validate(); err != nil {
+		apperror.SendError(w, apperror.BadRequest(err))
+		return
+	}
+
+	settings, err := h.uc.FindAwardSettings(r.Context(), req)
+	if err != nil {
+		apperror.SendError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(settings); err != nil {
+		apperror.SendError(w, apperror.InternalServerError(err))
+	}
+}
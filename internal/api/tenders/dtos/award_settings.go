@@ -0,0 +1,59 @@
+package dtos
+
+import (
+	"github.com/invopop/validation"
+
+	"avito-tenders/internal/entity"
+)
+
+type AwardSettingsBody struct {
+	RejectCompetingBids bool    `json:"rejectCompetingBids"`
+	RejectionFeedback   *string `json:"rejectionFeedback,omitempty"`
+}
+
+type SetAwardSettingsRequest struct {
+	TenderID string `json:"tenderId"`
+	Username string `json:"username"`
+	AwardSettingsBody
+}
+
+func (r SetAwardSettingsRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.TenderID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required),
+		validation.Field(&r.RejectionFeedback, validation.NilOrNotEmpty, validation.Length(1, 1000)))
+}
+
+func (r SetAwardSettingsRequest) ToEntity() entity.AwardSettings {
+	return entity.AwardSettings{
+		TenderID:            r.TenderID,
+		RejectCompetingBids: r.RejectCompetingBids,
+		RejectionFeedback:   r.RejectionFeedback,
+	}
+}
+
+type FindAwardSettingsRequest struct {
+	TenderID string `json:"tenderId"`
+	Username string `json:"username"`
+}
+
+func (r FindAwardSettingsRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.TenderID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type AwardSettingsResponse struct {
+	TenderID string `json:"tenderId"`
+	AwardSettingsBody
+}
+
+func NewAwardSettingsResponse(settings entity.AwardSettings) AwardSettingsResponse {
+	return AwardSettingsResponse{
+		TenderID: settings.TenderID,
+		AwardSettingsBody: AwardSettingsBody{
+			RejectCompetingBids: settings.RejectCompetingBids,
+			RejectionFeedback:   settings.RejectionFeedback,
+		},
+	}
+}
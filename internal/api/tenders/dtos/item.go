@@ -0,0 +1,121 @@
+package dtos
+
+import (
+	"errors"
+
+	"github.com/invopop/validation"
+	"github.com/shopspring/decimal"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/types"
+)
+
+// positiveQuantity checks that quantity is greater than zero. Nil quantity is skipped.
+func positiveQuantity(value interface{}) error {
+	switch quantity := value.(type) {
+	case decimal.Decimal:
+		if !quantity.IsPositive() {
+			return errors.New("must be greater than zero")
+		}
+	case *decimal.Decimal:
+		if quantity != nil && !quantity.IsPositive() {
+			return errors.New("must be greater than zero")
+		}
+	}
+
+	return nil
+}
+
+type CreateItemBody struct {
+	Description string          `json:"description"`
+	Unit        string          `json:"unit"`
+	Quantity    decimal.Decimal `json:"quantity"`
+}
+
+type CreateItemRequest struct {
+	TenderID string `json:"tenderId"`
+	Username string `json:"username"`
+	CreateItemBody
+}
+
+func (r CreateItemRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.TenderID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required),
+		validation.Field(&r.Description, validation.Required, validation.Length(1, 500)),
+		validation.Field(&r.Unit, validation.Required, validation.Length(1, 20)),
+		validation.Field(&r.Quantity, validation.By(positiveQuantity)))
+}
+
+func (r CreateItemRequest) ToEntity() entity.TenderItem {
+	return entity.TenderItem{
+		TenderID:    r.TenderID,
+		Description: r.Description,
+		Unit:        r.Unit,
+		Quantity:    r.Quantity,
+	}
+}
+
+type EditItemBody struct {
+	Description string           `json:"description,omitempty"`
+	Unit        string           `json:"unit,omitempty"`
+	Quantity    *decimal.Decimal `json:"quantity,omitempty"`
+}
+
+type EditItemRequest struct {
+	TenderID string `json:"tenderId"`
+	ItemID   string `json:"itemId"`
+	Username string `json:"username"`
+	EditItemBody
+}
+
+func (r EditItemRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.TenderID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.ItemID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required),
+		validation.Field(&r.Description, validation.Length(1, 500)),
+		validation.Field(&r.Unit, validation.Length(1, 20)),
+		validation.Field(&r.Quantity, validation.By(positiveQuantity)))
+}
+
+type FindItemsRequest struct {
+	TenderID string `json:"tenderId"`
+	Username string `json:"username"`
+}
+
+func (r FindItemsRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.TenderID, validation.Required, validation.Length(1, 100)))
+}
+
+type ItemResponse struct {
+	ID          string            `json:"id"`
+	TenderID    string            `json:"tenderId"`
+	Description string            `json:"description"`
+	Unit        string            `json:"unit"`
+	Quantity    decimal.Decimal   `json:"quantity"`
+	Version     int               `json:"version"`
+	CreatedAt   types.RFC3339Time `json:"createdAt"`
+}
+
+func NewItemResponse(item entity.TenderItem) ItemResponse {
+	return ItemResponse{
+		ID:          item.ID,
+		TenderID:    item.TenderID,
+		Description: item.Description,
+		Unit:        item.Unit,
+		Quantity:    item.Quantity,
+		Version:     item.Version,
+		CreatedAt:   types.RFCFromTime(item.CreatedAt),
+	}
+}
+
+func NewItemResponseList(items []entity.TenderItem) []ItemResponse {
+	itemsResponseList := make([]ItemResponse, 0, len(items))
+	for i := range items {
+		itemsResponseList = append(itemsResponseList, NewItemResponse(items[i]))
+	}
+
+	return itemsResponseList
+}
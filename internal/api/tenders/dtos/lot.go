@@ -0,0 +1,127 @@
+package dtos
+
+import (
+	"github.com/invopop/validation"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/types"
+)
+
+type CreateLotBody struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type CreateLotRequest struct {
+	TenderID string `json:"tenderId"`
+	Username string `json:"username"`
+	CreateLotBody
+}
+
+func (r CreateLotRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.TenderID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required),
+		validation.Field(&r.Name, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Description, validation.Required, validation.Length(1, 500)))
+}
+
+func (r CreateLotRequest) ToEntity() entity.TenderLot {
+	return entity.TenderLot{
+		TenderID:    r.TenderID,
+		Name:        r.Name,
+		Description: r.Description,
+	}
+}
+
+type EditLotBody struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type EditLotRequest struct {
+	TenderID string `json:"tenderId"`
+	LotID    string `json:"lotId"`
+	Username string `json:"username"`
+	EditLotBody
+}
+
+func (r EditLotRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.TenderID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.LotID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required),
+		validation.Field(&r.Name, validation.Length(1, 100)),
+		validation.Field(&r.Description, validation.Length(1, 500)))
+}
+
+type CancelLotRequest struct {
+	TenderID string `json:"tenderId"`
+	LotID    string `json:"lotId"`
+	Username string `json:"username"`
+}
+
+func (r CancelLotRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.TenderID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.LotID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type RollbackLotRequest struct {
+	TenderID string `json:"tenderId"`
+	LotID    string `json:"lotId"`
+	Version  int    `json:"version"`
+	Username string `json:"username"`
+}
+
+func (r RollbackLotRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.TenderID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.LotID, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Version, validation.Required, validation.Min(1)),
+		validation.Field(&r.Username, validation.Required))
+}
+
+type FindLotsRequest struct {
+	TenderID string `json:"tenderId"`
+	Username string `json:"username"`
+}
+
+func (r FindLotsRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.TenderID, validation.Required, validation.Length(1, 100)))
+}
+
+type LotResponse struct {
+	ID           string            `json:"id"`
+	TenderID     string            `json:"tenderId"`
+	Name         string            `json:"name"`
+	Description  string            `json:"description"`
+	Status       entity.LotStatus  `json:"status"`
+	AwardedBidID *string           `json:"awardedBidId,omitempty"`
+	Version      int               `json:"version"`
+	CreatedAt    types.RFC3339Time `json:"createdAt"`
+}
+
+func NewLotResponse(lot entity.TenderLot) LotResponse {
+	return LotResponse{
+		ID:           lot.ID,
+		TenderID:     lot.TenderID,
+		Name:         lot.Name,
+		Description:  lot.Description,
+		Status:       lot.Status,
+		AwardedBidID: lot.AwardedBidID,
+		Version:      lot.Version,
+		CreatedAt:    types.RFCFromTime(lot.CreatedAt),
+	}
+}
+
+func NewLotResponseList(lots []entity.TenderLot) []LotResponse {
+	lotsResponseList := make([]LotResponse, 0, len(lots))
+	for i := range lots {
+		lotsResponseList = append(lotsResponseList, NewLotResponse(lots[i]))
+	}
+
+	return lotsResponseList
+}
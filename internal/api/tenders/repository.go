@@ -18,4 +18,20 @@ type Repository interface {
 	FindByID(ctx context.Context, id string) (entity.Tender, error)
 	FindByCreatorUsername(ctx context.Context, username string, pagination queryparams.Pagination) ([]entity.Tender, error)
 	FindByIDFromHistory(ctx context.Context, id string, version int) (entity.Tender, error)
+	FindByIDs(ctx context.Context, ids []string) ([]entity.Tender, error)
+	FindHistoryByIDs(ctx context.Context, ids []string) ([]entity.Tender, error)
+
+	CreateLot(ctx context.Context, lot entity.TenderLot) (entity.TenderLot, error)
+	UpdateLot(ctx context.Context, lot entity.TenderLot) (entity.TenderLot, error)
+	FindLotByID(ctx context.Context, id string) (entity.TenderLot, error)
+	FindLotsByTenderID(ctx context.Context, tenderID string) ([]entity.TenderLot, error)
+	FindLotByIDFromHistory(ctx context.Context, id string, version int) (entity.TenderLot, error)
+
+	CreateItem(ctx context.Context, item entity.TenderItem) (entity.TenderItem, error)
+	UpdateItem(ctx context.Context, item entity.TenderItem) (entity.TenderItem, error)
+	FindItemByID(ctx context.Context, id string) (entity.TenderItem, error)
+	FindItemsByTenderID(ctx context.Context, tenderID string) ([]entity.TenderItem, error)
+
+	FindAwardSettings(ctx context.Context, tenderID string) (entity.AwardSettings, error)
+	SetAwardSettings(ctx context.Context, settings entity.AwardSettings) (entity.AwardSettings, error)
 }
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+func (r Repository) CreateItem(ctx context.Context, item entity.TenderItem) (entity.TenderItem, error) {
+	row := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx, `
+		insert into tender_items(tender_id, description, unit, quantity)
+		values ($1, $2, $3, $4)
+		returning id, tender_id, description, unit, quantity, version, created_at`,
+		item.TenderID,
+		item.Description,
+		item.Unit,
+		item.Quantity)
+	if row.Err() != nil {
+		fwcontext.GetLogger(ctx).Error("failed to insert tender item", "error", row.Err())
+		return entity.TenderItem{}, apperror.BadRequest(apperror.ErrInvalidInput)
+	}
+
+	var result entity.TenderItem
+	if err := row.StructScan(&result); err != nil {
+		fwcontext.GetLogger(ctx).Error("failed to scan created tender item", "error", err)
+		return entity.TenderItem{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return result, nil
+}
+
+func (r Repository) UpdateItem(ctx context.Context, item entity.TenderItem) (entity.TenderItem, error) {
+	row := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx, `
+		update tender_items set
+		                        description = $1,
+		                        unit = $2,
+		                        quantity = $3,
+		                        version = version + 1
+		                    where id = $4
+		returning id, tender_id, description, unit, quantity, version, created_at`,
+		item.Description,
+		item.Unit,
+		item.Quantity,
+		item.ID)
+	if row.Err() != nil {
+		fwcontext.GetLogger(ctx).Error("failed to update tender item", "error", row.Err())
+		return entity.TenderItem{}, apperror.BadRequest(apperror.ErrInvalidInput)
+	}
+
+	var result entity.TenderItem
+	if err := row.StructScan(&result); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.TenderItem{}, apperror.NotFound(apperror.ErrNotFound)
+		}
+
+		fwcontext.GetLogger(ctx).Error("failed to scan updated tender item", "error", err)
+
+		return entity.TenderItem{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return result, nil
+}
+
+func (r Repository) FindItemByID(ctx context.Context, id string) (entity.TenderItem, error) {
+	row := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx, `
+		select id, tender_id, description, unit, quantity, version, created_at from tender_items
+		where id = $1`,
+		id)
+	if row.Err() != nil {
+		return entity.TenderItem{}, apperror.BadRequest(apperror.ErrInvalidInput)
+	}
+
+	var item entity.TenderItem
+	if err := row.StructScan(&item); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.TenderItem{}, apperror.NotFound(apperror.ErrNotFound)
+		}
+
+		fwcontext.GetLogger(ctx).Error("failed to scan tender item", "error", err)
+
+		return entity.TenderItem{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return item, nil
+}
+
+func (r Repository) FindItemsByTenderID(ctx context.Context, tenderID string) ([]entity.TenderItem, error) {
+	itemList := make([]entity.TenderItem, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &itemList, `
+		select id, tender_id, description, unit, quantity, version, created_at from tender_items
+		where tender_id = $1
+		order by created_at, description`,
+		tenderID)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("failed to find tender items", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return itemList, nil
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+func (r Repository) CreateLot(ctx context.Context, lot entity.TenderLot) (entity.TenderLot, error) {
+	row := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx, `
+		insert into tender_lots(tender_id, name, description, status)
+		values ($1, $2, $3, $4)
+		returning id, tender_id, name, description, status, awarded_bid_id, version, created_at`,
+		lot.TenderID,
+		lot.Name,
+		lot.Description,
+		entity.LotOpen)
+	if row.Err() != nil {
+		fwcontext.GetLogger(ctx).Error("failed to insert tender lot", "error", row.Err())
+		return entity.TenderLot{}, apperror.BadRequest(apperror.ErrInvalidInput)
+	}
+
+	var result entity.TenderLot
+	if err := row.StructScan(&result); err != nil {
+		fwcontext.GetLogger(ctx).Error("failed to scan created tender lot", "error", err)
+		return entity.TenderLot{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return result, nil
+}
+
+func (r Repository) UpdateLot(ctx context.Context, lot entity.TenderLot) (entity.TenderLot, error) {
+	row := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx, `
+		update tender_lots set
+		                       name = $1,
+		                       description = $2,
+		                       status = $3,
+		                       awarded_bid_id = $4,
+		                       version = version + 1
+		                   where id = $5
+		returning id, tender_id, name, description, status, awarded_bid_id, version, created_at`,
+		lot.Name,
+		lot.Description,
+		lot.Status.String(),
+		lot.AwardedBidID,
+		lot.ID)
+	if row.Err() != nil {
+		fwcontext.GetLogger(ctx).Error("failed to update tender lot", "error", row.Err())
+		return entity.TenderLot{}, apperror.BadRequest(apperror.ErrInvalidInput)
+	}
+
+	var result entity.TenderLot
+	if err := row.StructScan(&result); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.TenderLot{}, apperror.NotFound(apperror.ErrNotFound)
+		}
+
+		fwcontext.GetLogger(ctx).Error("failed to scan updated tender lot", "error", err)
+
+		return entity.TenderLot{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return result, nil
+}
+
+func (r Repository) FindLotByID(ctx context.Context, id string) (entity.TenderLot, error) {
+	row := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx, `
+		select id, tender_id, name, description, status, awarded_bid_id, version, created_at from tender_lots
+		where id = $1`,
+		id)
+	if row.Err() != nil {
+		return entity.TenderLot{}, apperror.BadRequest(apperror.ErrInvalidInput)
+	}
+
+	var lot entity.TenderLot
+	if err := row.StructScan(&lot); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.TenderLot{}, apperror.NotFound(apperror.ErrNotFound)
+		}
+
+		fwcontext.GetLogger(ctx).Error("failed to scan tender lot", "error", err)
+
+		return entity.TenderLot{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return lot, nil
+}
+
+func (r Repository) FindLotsByTenderID(ctx context.Context, tenderID string) ([]entity.TenderLot, error) {
+	lotList := make([]entity.TenderLot, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &lotList, `
+		select id, tender_id, name, description, status, awarded_bid_id, version, created_at from tender_lots
+		where tender_id = $1
+		order by created_at, name`,
+		tenderID)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("failed to find tender lots", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return lotList, nil
+}
+
+func (r Repository) FindLotByIDFromHistory(ctx context.Context, id string, version int) (entity.TenderLot, error) {
+	row := r.getter.DefaultTrOrDB(ctx, r.db).QueryRowxContext(ctx, `
+		select lot_id as id, tender_id, name, description, status, awarded_bid_id, version, created_at from tender_lots_history
+		where lot_id = $1 and version = $2`,
+		id, version)
+	if row.Err() != nil {
+		return entity.TenderLot{}, apperror.BadRequest(apperror.ErrInvalidInput)
+	}
+
+	var lot entity.TenderLot
+	if err := row.StructScan(&lot); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.TenderLot{}, apperror.NotFound(apperror.ErrNotFound)
+		}
+
+		fwcontext.GetLogger(ctx).Error("failed to scan old tender lot", "error", err)
+
+		return entity.TenderLot{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return lot, nil
+}
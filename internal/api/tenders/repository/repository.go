@@ -5,16 +5,17 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"log/slog"
 	"strings"
 
 	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"avito-tenders/internal/api/tenders"
 	"avito-tenders/internal/entity"
 	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
 	"avito-tenders/pkg/queryparams"
 )
 
@@ -42,7 +43,7 @@ func (r Repository) FindByIDFromHistory(ctx context.Context, id string, version
 			return entity.Tender{}, apperror.NotFound(apperror.ErrNotFound)
 		}
 
-		slog.Error("failed to scan old tender", "error", err)
+		fwcontext.GetLogger(ctx).Error("failed to scan old tender", "error", err)
 
 		return entity.Tender{}, apperror.InternalServerError(apperror.ErrInternal)
 	}
@@ -66,7 +67,7 @@ func (r Repository) Create(ctx context.Context, tender entity.Tender) (entity.Te
 			return entity.Tender{}, apperror.Unauthorized(apperror.ErrUserDoesNotExist)
 		}
 
-		slog.Error("failed to insert tender", "error", row.Err())
+		fwcontext.GetLogger(ctx).Error("failed to insert tender", "error", row.Err())
 
 		return entity.Tender{}, apperror.InternalServerError(row.Err())
 	}
@@ -104,7 +105,7 @@ func (r Repository) Update(ctx context.Context, tender entity.Tender) (entity.Te
 			}
 		}
 
-		slog.Error("failed to update tender", "error", row.Err())
+		fwcontext.GetLogger(ctx).Error("failed to update tender", "error", row.Err())
 
 		return entity.Tender{}, apperror.InternalServerError(row.Err())
 	}
@@ -143,7 +144,7 @@ func (r Repository) FindByID(ctx context.Context, id string) (entity.Tender, err
 		id)
 
 	if row.Err() != nil {
-		slog.Error("failed to select", "error", row.Err())
+		fwcontext.GetLogger(ctx).Error("failed to select", "error", row.Err())
 		return entity.Tender{}, apperror.InternalServerError(apperror.ErrInternal)
 	}
 
@@ -153,7 +154,7 @@ func (r Repository) FindByID(ctx context.Context, id string) (entity.Tender, err
 			return entity.Tender{}, apperror.NotFound(apperror.ErrNotFound)
 		}
 
-		slog.Error("failed to scan", "error", err)
+		fwcontext.GetLogger(ctx).Error("failed to scan", "error", err)
 
 		return entity.Tender{}, apperror.InternalServerError(apperror.ErrInternal)
 	}
@@ -187,7 +188,38 @@ func (r Repository) GetAll(ctx context.Context, filter tenders.TenderFilter, pag
 	tenderList := make([]entity.Tender, 0)
 	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &tenderList, query.String(), filterValues...)
 	if err != nil {
-		slog.Error("failed to get all tenders", "error", err)
+		fwcontext.GetLogger(ctx).Error("failed to get all tenders", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return tenderList, nil
+}
+
+func (r Repository) FindByIDs(ctx context.Context, ids []string) ([]entity.Tender, error) {
+	tenderList := make([]entity.Tender, 0, len(ids))
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &tenderList, `
+		select id, name, description, service_type, status, organization_id, version, created_at from tenders
+		where id = any($1::uuid[])`,
+		pq.Array(ids))
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("failed to find tenders by ids", "error", err)
+		return nil, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return tenderList, nil
+}
+
+func (r Repository) FindHistoryByIDs(ctx context.Context, ids []string) ([]entity.Tender, error) {
+	tenderList := make([]entity.Tender, 0)
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).SelectContext(ctx, &tenderList, `
+		select tender_id as id, name, description, service_type, status, organization_id, version, created_at from tenders_history
+		where tender_id = any($1::uuid[])
+		order by tender_id, version`,
+		pq.Array(ids))
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("failed to find tenders history by ids", "error", err)
 		return nil, apperror.InternalServerError(apperror.ErrInternal)
 	}
 
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/fwcontext"
+)
+
+// FindAwardSettings returns award settings of the tender, default ones if they were never set.
+func (r Repository) FindAwardSettings(ctx context.Context, tenderID string) (entity.AwardSettings, error) {
+	var settings entity.AwardSettings
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &settings, `
+		select tender_id, reject_competing_bids, rejection_feedback from tender_award_settings
+		where tender_id = $1`, tenderID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.AwardSettings{TenderID: tenderID}, nil
+		}
+
+		fwcontext.GetLogger(ctx).Error("failed to select award settings", "error", err)
+
+		return entity.AwardSettings{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return settings, nil
+}
+
+func (r Repository) SetAwardSettings(ctx context.Context, settings entity.AwardSettings) (entity.AwardSettings, error) {
+	var result entity.AwardSettings
+
+	err := r.getter.DefaultTrOrDB(ctx, r.db).GetContext(ctx, &result, `
+		insert into tender_award_settings(tender_id, reject_competing_bids, rejection_feedback)
+		values ($1, $2, $3)
+		on conflict (tender_id) do update set
+		    reject_competing_bids = excluded.reject_competing_bids,
+		    rejection_feedback = excluded.rejection_feedback,
+		    updated_at = now()
+		returning tender_id, reject_competing_bids, rejection_feedback`,
+		settings.TenderID, settings.RejectCompetingBids, settings.RejectionFeedback)
+	if err != nil {
+		fwcontext.GetLogger(ctx).Error("failed to set award settings", "error", err)
+		return entity.AwardSettings{}, apperror.InternalServerError(apperror.ErrInternal)
+	}
+
+	return result, nil
+}
@@ -17,4 +17,17 @@ type Usecase interface {
 	GetAll(ctx context.Context, filter TenderFilter, pagination queryparams.Pagination) ([]dtos.TenderResponse, error)
 	GetTenderStatus(ctx context.Context, id string, request dtos.TenderStatus) (dtos.TenderResponse, error)
 	FindByUsername(ctx context.Context, username string, pagination queryparams.Pagination) ([]dtos.TenderResponse, error)
+
+	CreateLot(ctx context.Context, request dtos.CreateLotRequest) (dtos.LotResponse, error)
+	EditLot(ctx context.Context, request dtos.EditLotRequest) (dtos.LotResponse, error)
+	CancelLot(ctx context.Context, request dtos.CancelLotRequest) (dtos.LotResponse, error)
+	RollbackLot(ctx context.Context, request dtos.RollbackLotRequest) (dtos.LotResponse, error)
+	FindLots(ctx context.Context, request dtos.FindLotsRequest) ([]dtos.LotResponse, error)
+
+	CreateItem(ctx context.Context, request dtos.CreateItemRequest) (dtos.ItemResponse, error)
+	EditItem(ctx context.Context, request dtos.EditItemRequest) (dtos.ItemResponse, error)
+	FindItems(ctx context.Context, request dtos.FindItemsRequest) ([]dtos.ItemResponse, error)
+
+	SetAwardSettings(ctx context.Context, request dtos.SetAwardSettingsRequest) (dtos.AwardSettingsResponse, error)
+	FindAwardSettings(ctx context.Context, request dtos.FindAwardSettingsRequest) (dtos.AwardSettingsResponse, error)
 }
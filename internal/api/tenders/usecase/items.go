@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+
+	"avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+)
+
+// CreateItem adds line item to the tender. Bill of quantities can be changed only before tender is published,
+// so that every bid quotes the same set of items.
+func (u *Usecase) CreateItem(ctx context.Context, request dtos.CreateItemRequest) (dtos.ItemResponse, error) {
+	var item entity.TenderItem
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		tender, err := u.findResponsibleTender(ctx, request.TenderID, request.Username)
+		if err != nil {
+			return err
+		}
+		if tender.Status != entity.TenderCreated {
+			return apperror.Forbidden(apperror.ErrForbidden)
+		}
+
+		item, err = u.repo.CreateItem(ctx, request.ToEntity())
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return dtos.ItemResponse{}, err
+	}
+
+	return dtos.NewItemResponse(item), nil
+}
+
+func (u *Usecase) EditItem(ctx context.Context, request dtos.EditItemRequest) (dtos.ItemResponse, error) {
+	var item entity.TenderItem
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		tender, err := u.findResponsibleTender(ctx, request.TenderID, request.Username)
+		if err != nil {
+			return err
+		}
+		if tender.Status != entity.TenderCreated {
+			return apperror.Forbidden(apperror.ErrForbidden)
+		}
+
+		oldItem, err := u.repo.FindItemByID(ctx, request.ItemID)
+		if err != nil {
+			return err
+		}
+		if oldItem.TenderID != tender.ID {
+			return apperror.NotFound(apperror.ErrNotFound)
+		}
+
+		if len(request.Description) != 0 {
+			oldItem.Description = request.Description
+		}
+		if len(request.Unit) != 0 {
+			oldItem.Unit = request.Unit
+		}
+		if request.Quantity != nil {
+			oldItem.Quantity = *request.Quantity
+		}
+
+		item, err = u.repo.UpdateItem(ctx, oldItem)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return dtos.ItemResponse{}, err
+	}
+
+	return dtos.NewItemResponse(item), nil
+}
+
+func (u *Usecase) FindItems(ctx context.Context, request dtos.FindItemsRequest) ([]dtos.ItemResponse, error) {
+	var items []entity.TenderItem
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		tender, err := u.repo.FindByID(ctx, request.TenderID)
+		if err != nil {
+			return err
+		}
+
+		// Items of not published tenders are visible only to responsible users.
+		if tender.Status != entity.TenderPublished {
+			if request.Username == "" {
+				return apperror.Unauthorized(apperror.ErrUserEmpty)
+			}
+
+			if _, err := u.empRepo.FindByUsername(ctx, request.Username); err != nil {
+				return err
+			}
+
+			if _, err := u.findResponsibleTender(ctx, tender.ID, request.Username); err != nil {
+				return err
+			}
+		}
+
+		items, err = u.repo.FindItemsByTenderID(ctx, request.TenderID)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dtos.NewItemResponseList(items), nil
+}
@@ -0,0 +1,244 @@
+package usecase
+
+import (
+	"context"
+
+	"avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/metrics"
+)
+
+func (u *Usecase) CreateLot(ctx context.Context, request dtos.CreateLotRequest) (dtos.LotResponse, error) {
+	var lot entity.TenderLot
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		tender, err := u.findResponsibleTender(ctx, request.TenderID, request.Username)
+		if err != nil {
+			return err
+		}
+		if tender.Status == entity.TenderClosed {
+			return apperror.Forbidden(apperror.ErrForbidden)
+		}
+
+		lot, err = u.repo.CreateLot(ctx, request.ToEntity())
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return dtos.LotResponse{}, err
+	}
+
+	return dtos.NewLotResponse(lot), nil
+}
+
+func (u *Usecase) EditLot(ctx context.Context, request dtos.EditLotRequest) (dtos.LotResponse, error) {
+	var lot entity.TenderLot
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		oldLot, err := u.findResponsibleLot(ctx, request.TenderID, request.LotID, request.Username)
+		if err != nil {
+			return err
+		}
+		if oldLot.Status.IsFinal() {
+			return apperror.Forbidden(apperror.ErrForbidden)
+		}
+
+		if len(request.Name) != 0 {
+			oldLot.Name = request.Name
+		}
+		if len(request.Description) != 0 {
+			oldLot.Description = request.Description
+		}
+
+		lot, err = u.repo.UpdateLot(ctx, oldLot)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return dtos.LotResponse{}, err
+	}
+
+	return dtos.NewLotResponse(lot), nil
+}
+
+func (u *Usecase) CancelLot(ctx context.Context, request dtos.CancelLotRequest) (dtos.LotResponse, error) {
+	var (
+		lot          entity.TenderLot
+		tenderClosed bool
+	)
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		oldLot, err := u.findResponsibleLot(ctx, request.TenderID, request.LotID, request.Username)
+		if err != nil {
+			return err
+		}
+		if oldLot.Status.IsFinal() {
+			return apperror.Forbidden(apperror.ErrForbidden)
+		}
+
+		oldLot.Status = entity.LotCancelled
+
+		lot, err = u.repo.UpdateLot(ctx, oldLot)
+		if err != nil {
+			return err
+		}
+
+		tenderClosed, err = u.closeTenderIfLotsSettled(ctx, request.TenderID)
+
+		return err
+	})
+	if err != nil {
+		return dtos.LotResponse{}, err
+	}
+
+	if tenderClosed {
+		metrics.TendersClosed.Inc()
+	}
+
+	return dtos.NewLotResponse(lot), nil
+}
+
+// RollbackLot restores name and description of the lot from the given version.
+// Status and award are driven by the tender workflow, so they are kept as is.
+func (u *Usecase) RollbackLot(ctx context.Context, request dtos.RollbackLotRequest) (dtos.LotResponse, error) {
+	var lot entity.TenderLot
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		currentLot, err := u.findResponsibleLot(ctx, request.TenderID, request.LotID, request.Username)
+		if err != nil {
+			return err
+		}
+		if currentLot.Status.IsFinal() {
+			return apperror.Forbidden(apperror.ErrForbidden)
+		}
+
+		oldLot, err := u.repo.FindLotByIDFromHistory(ctx, request.LotID, request.Version)
+		if err != nil {
+			return err
+		}
+
+		currentLot.Name = oldLot.Name
+		currentLot.Description = oldLot.Description
+
+		lot, err = u.repo.UpdateLot(ctx, currentLot)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return dtos.LotResponse{}, err
+	}
+
+	return dtos.NewLotResponse(lot), nil
+}
+
+func (u *Usecase) FindLots(ctx context.Context, request dtos.FindLotsRequest) ([]dtos.LotResponse, error) {
+	var lots []entity.TenderLot
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		tender, err := u.repo.FindByID(ctx, request.TenderID)
+		if err != nil {
+			return err
+		}
+
+		// Lots of not published tenders are visible only to responsible users.
+		if tender.Status != entity.TenderPublished {
+			if request.Username == "" {
+				return apperror.Unauthorized(apperror.ErrUserEmpty)
+			}
+
+			if _, err := u.empRepo.FindByUsername(ctx, request.Username); err != nil {
+				return err
+			}
+
+			responsible, err := u.orgRepo.IsOrganizationResponsible(ctx, tender.OrganizationID, request.Username)
+			if err != nil {
+				return err
+			}
+			if !responsible {
+				return apperror.Forbidden(apperror.ErrForbidden)
+			}
+		}
+
+		lots, err = u.repo.FindLotsByTenderID(ctx, request.TenderID)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dtos.NewLotResponseList(lots), nil
+}
+
+// findResponsibleTender returns tender if user is responsible for its organization.
+func (u *Usecase) findResponsibleTender(ctx context.Context, tenderID, username string) (entity.Tender, error) {
+	tender, err := u.repo.FindByID(ctx, tenderID)
+	if err != nil {
+		return entity.Tender{}, err
+	}
+
+	responsible, err := u.orgRepo.IsOrganizationResponsible(ctx, tender.OrganizationID, username)
+	if err != nil {
+		return entity.Tender{}, err
+	}
+	if !responsible {
+		return entity.Tender{}, apperror.Forbidden(apperror.ErrForbidden)
+	}
+
+	return tender, nil
+}
+
+// findResponsibleLot returns lot of the tender if user is responsible for tender's organization.
+func (u *Usecase) findResponsibleLot(ctx context.Context, tenderID, lotID, username string) (entity.TenderLot, error) {
+	if _, err := u.findResponsibleTender(ctx, tenderID, username); err != nil {
+		return entity.TenderLot{}, err
+	}
+
+	lot, err := u.repo.FindLotByID(ctx, lotID)
+	if err != nil {
+		return entity.TenderLot{}, err
+	}
+	if lot.TenderID != tenderID {
+		return entity.TenderLot{}, apperror.NotFound(apperror.ErrNotFound)
+	}
+
+	return lot, nil
+}
+
+// closeTenderIfLotsSettled closes tender when every lot is either awarded or cancelled.
+// Returns true if tender was closed.
+func (u *Usecase) closeTenderIfLotsSettled(ctx context.Context, tenderID string) (bool, error) {
+	lots, err := u.repo.FindLotsByTenderID(ctx, tenderID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, lot := range lots {
+		if !lot.Status.IsFinal() {
+			return false, nil
+		}
+	}
+
+	tender, err := u.repo.FindByID(ctx, tenderID)
+	if err != nil {
+		return false, err
+	}
+	if tender.Status == entity.TenderClosed {
+		return false, nil
+	}
+
+	tender.Status = entity.TenderClosed
+	if _, err := u.repo.Update(ctx, tender); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
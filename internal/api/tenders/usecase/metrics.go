@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/metrics"
+)
+
+// observeStatusChange counts tenders that moved to published or closed status.
+func observeStatusChange(previous, current entity.TenderStatus) {
+	if previous == current {
+		return
+	}
+
+	switch current {
+	case entity.TenderPublished:
+		metrics.TendersPublished.Inc()
+	case entity.TenderClosed:
+		metrics.TendersClosed.Inc()
+	}
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+
+	"avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/internal/entity"
+)
+
+// SetAwardSettings configures competing bids handling on award. Settings apply to awards made after the change.
+func (u *Usecase) SetAwardSettings(ctx context.Context, request dtos.SetAwardSettingsRequest) (dtos.AwardSettingsResponse, error) {
+	var settings entity.AwardSettings
+	err := u.trManager.Do(ctx, func(ctx context.Context) error {
+		if _, err := u.findResponsibleTender(ctx, request.TenderID, request.Username); err != nil {
+			return err
+		}
+
+		var err error
+		settings, err = u.repo.SetAwardSettings(ctx, request.ToEntity())
+
+		return err
+	})
+	if err != nil {
+		return dtos.AwardSettingsResponse{}, err
+	}
+
+	return dtos.NewAwardSettingsResponse(settings), nil
+}
+
+func (u *Usecase) FindAwardSettings(ctx context.Context, request dtos.FindAwardSettingsRequest) (dtos.AwardSettingsResponse, error) {
+	if _, err := u.findResponsibleTender(ctx, request.TenderID, request.Username); err != nil {
+		return dtos.AwardSettingsResponse{}, err
+	}
+
+	settings, err := u.repo.FindAwardSettings(ctx, request.TenderID)
+	if err != nil {
+		return dtos.AwardSettingsResponse{}, err
+	}
+
+	return dtos.NewAwardSettingsResponse(settings), nil
+}
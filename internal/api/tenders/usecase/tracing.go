@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+
+	"avito-tenders/internal/api/tenders"
+	"avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/pkg/queryparams"
+	"avito-tenders/pkg/tracing"
+)
+
+// TracingUsecase wraps usecase to trace its calls.
+type TracingUsecase struct {
+	next tenders.Usecase
+}
+
+func NewTracingUsecase(next tenders.Usecase) *TracingUsecase {
+	return &TracingUsecase{next: next}
+}
+
+func (u TracingUsecase) Create(ctx context.Context, request dtos.CreateTenderRequest) (dtos.TenderResponse, error) {
+	return tracing.Do(ctx, "tenders.Create", func(ctx context.Context) (dtos.TenderResponse, error) {
+		return u.next.Create(ctx, request)
+	})
+}
+
+func (u TracingUsecase) Edit(ctx context.Context, id string, request dtos.EditTenderRequest) (dtos.TenderResponse, error) {
+	return tracing.Do(ctx, "tenders.Edit", func(ctx context.Context) (dtos.TenderResponse, error) {
+		return u.next.Edit(ctx, id, request)
+	})
+}
+
+func (u TracingUsecase) EditStatus(ctx context.Context, id string, request dtos.EditTenderStatusRequest) (dtos.TenderResponse, error) {
+	return tracing.Do(ctx, "tenders.EditStatus", func(ctx context.Context) (dtos.TenderResponse, error) {
+		return u.next.EditStatus(ctx, id, request)
+	})
+}
+
+func (u TracingUsecase) Rollback(ctx context.Context, id string, request dtos.RollbackTenderRequest) (dtos.TenderResponse, error) {
+	return tracing.Do(ctx, "tenders.Rollback", func(ctx context.Context) (dtos.TenderResponse, error) {
+		return u.next.Rollback(ctx, id, request)
+	})
+}
+
+func (u TracingUsecase) GetAll(ctx context.Context, filter tenders.TenderFilter, pagination queryparams.Pagination) ([]dtos.TenderResponse, error) {
+	return tracing.Do(ctx, "tenders.GetAll", func(ctx context.Context) ([]dtos.TenderResponse, error) {
+		return u.next.GetAll(ctx, filter, pagination)
+	})
+}
+
+func (u TracingUsecase) GetTenderStatus(ctx context.Context, id string, request dtos.TenderStatus) (dtos.TenderResponse, error) {
+	return tracing.Do(ctx, "tenders.GetTenderStatus", func(ctx context.Context) (dtos.TenderResponse, error) {
+		return u.next.GetTenderStatus(ctx, id, request)
+	})
+}
+
+func (u TracingUsecase) FindByUsername(ctx context.Context, username string, pagination queryparams.Pagination) ([]dtos.TenderResponse, error) {
+	return tracing.Do(ctx, "tenders.FindByUsername", func(ctx context.Context) ([]dtos.TenderResponse, error) {
+		return u.next.FindByUsername(ctx, username, pagination)
+	})
+}
+
+func (u TracingUsecase) CreateLot(ctx context.Context, request dtos.CreateLotRequest) (dtos.LotResponse, error) {
+	return tracing.Do(ctx, "tenders.CreateLot", func(ctx context.Context) (dtos.LotResponse, error) {
+		return u.next.CreateLot(ctx, request)
+	})
+}
+
+func (u TracingUsecase) EditLot(ctx context.Context, request dtos.EditLotRequest) (dtos.LotResponse, error) {
+	return tracing.Do(ctx, "tenders.EditLot", func(ctx context.Context) (dtos.LotResponse, error) {
+		return u.next.EditLot(ctx, request)
+	})
+}
+
+func (u TracingUsecase) CancelLot(ctx context.Context, request dtos.CancelLotRequest) (dtos.LotResponse, error) {
+	return tracing.Do(ctx, "tenders.CancelLot", func(ctx context.Context) (dtos.LotResponse, error) {
+		return u.next.CancelLot(ctx, request)
+	})
+}
+
+func (u TracingUsecase) RollbackLot(ctx context.Context, request dtos.RollbackLotRequest) (dtos.LotResponse, error) {
+	return tracing.Do(ctx, "tenders.RollbackLot", func(ctx context.Context) (dtos.LotResponse, error) {
+		return u.next.RollbackLot(ctx, request)
+	})
+}
+
+func (u TracingUsecase) FindLots(ctx context.Context, request dtos.FindLotsRequest) ([]dtos.LotResponse, error) {
+	return tracing.Do(ctx, "tenders.FindLots", func(ctx context.Context) ([]dtos.LotResponse, error) {
+		return u.next.FindLots(ctx, request)
+	})
+}
+
+func (u TracingUsecase) CreateItem(ctx context.Context, request dtos.CreateItemRequest) (dtos.ItemResponse, error) {
+	return tracing.Do(ctx, "tenders.CreateItem", func(ctx context.Context) (dtos.ItemResponse, error) {
+		return u.next.CreateItem(ctx, request)
+	})
+}
+
+func (u TracingUsecase) EditItem(ctx context.Context, request dtos.EditItemRequest) (dtos.ItemResponse, error) {
+	return tracing.Do(ctx, "tenders.EditItem", func(ctx context.Context) (dtos.ItemResponse, error) {
+		return u.next.EditItem(ctx, request)
+	})
+}
+
+func (u TracingUsecase) FindItems(ctx context.Context, request dtos.FindItemsRequest) ([]dtos.ItemResponse, error) {
+	return tracing.Do(ctx, "tenders.FindItems", func(ctx context.Context) ([]dtos.ItemResponse, error) {
+		return u.next.FindItems(ctx, request)
+	})
+}
+
+func (u TracingUsecase) SetAwardSettings(ctx context.Context, request dtos.SetAwardSettingsRequest) (dtos.AwardSettingsResponse, error) {
+	return tracing.Do(ctx, "tenders.SetAwardSettings", func(ctx context.Context) (dtos.AwardSettingsResponse, error) {
+		return u.next.SetAwardSettings(ctx, request)
+	})
+}
+
+func (u TracingUsecase) FindAwardSettings(ctx context.Context, request dtos.FindAwardSettingsRequest) (dtos.AwardSettingsResponse, error) {
+	return tracing.Do(ctx, "tenders.FindAwardSettings", func(ctx context.Context) (dtos.AwardSettingsResponse, error) {
+		return u.next.FindAwardSettings(ctx, request)
+	})
+}
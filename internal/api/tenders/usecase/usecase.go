@@ -6,31 +6,41 @@ import (
 
 	trm "github.com/avito-tech/go-transaction-manager/trm/v2/manager"
 
+	"avito-tenders/internal/api/attachments"
 	"avito-tenders/internal/api/employee"
 	"avito-tenders/internal/api/organization"
 	"avito-tenders/internal/api/tenders"
 	"avito-tenders/internal/api/tenders/dtos"
 	"avito-tenders/internal/entity"
 	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/metrics"
 	"avito-tenders/pkg/queryparams"
 )
 
 type Usecase struct {
-	repo      tenders.Repository
-	orgRepo   organization.Repository
-	empRepo   employee.Repository
-	trManager *trm.Manager
+	repo       tenders.Repository
+	orgRepo    organization.Repository
+	empRepo    employee.Repository
+	attachRepo attachments.Repository
+	trManager  *trm.Manager
 }
 
 type Opts struct {
-	Repo      tenders.Repository
-	OrgRepo   organization.Repository
-	TrManager *trm.Manager
-	EmpRepo   employee.Repository
+	Repo       tenders.Repository
+	OrgRepo    organization.Repository
+	TrManager  *trm.Manager
+	EmpRepo    employee.Repository
+	AttachRepo attachments.Repository
 }
 
 func NewUsecase(opts Opts) *Usecase {
-	return &Usecase{repo: opts.Repo, orgRepo: opts.OrgRepo, trManager: opts.TrManager, empRepo: opts.EmpRepo}
+	return &Usecase{
+		repo:       opts.Repo,
+		orgRepo:    opts.OrgRepo,
+		trManager:  opts.TrManager,
+		empRepo:    opts.EmpRepo,
+		attachRepo: opts.AttachRepo,
+	}
 }
 
 func (u *Usecase) Create(ctx context.Context, request dtos.CreateTenderRequest) (dtos.TenderResponse, error) {
@@ -60,6 +70,9 @@ func (u *Usecase) Create(ctx context.Context, request dtos.CreateTenderRequest)
 		return dtos.TenderResponse{}, err
 	}
 
+	metrics.TendersCreated.Inc()
+	observeStatusChange("", tender.Status)
+
 	return dtos.NewTenderResponse(tender), nil
 }
 
@@ -154,13 +167,17 @@ func (u *Usecase) FindByUsername(ctx context.Context, username string, paginatio
 }
 
 func (u *Usecase) EditStatus(ctx context.Context, id string, request dtos.EditTenderStatusRequest) (dtos.TenderResponse, error) {
-	var tender entity.Tender
+	var (
+		tender         entity.Tender
+		previousStatus entity.TenderStatus
+	)
 	err := u.trManager.Do(ctx, func(ctx context.Context) error {
 		oldTender, err := u.repo.FindByID(ctx, id)
 		if err != nil {
 			return err
 		}
 
+		previousStatus = oldTender.Status
 		oldTender.Status = request.Status
 
 		tender, err = u.repo.Update(ctx, oldTender)
@@ -174,6 +191,8 @@ func (u *Usecase) EditStatus(ctx context.Context, id string, request dtos.EditTe
 		return dtos.TenderResponse{}, err
 	}
 
+	observeStatusChange(previousStatus, tender.Status)
+
 	return dtos.NewTenderResponse(tender), nil
 }
 
@@ -198,7 +217,7 @@ func (u *Usecase) Rollback(ctx context.Context, id string, request dtos.Rollback
 			return err
 		}
 
-		return nil
+		return u.attachRepo.RestoreVersion(ctx, entity.AttachmentTender, tender.ID, request.Version, tender.Version)
 	})
 	if err != nil {
 		return dtos.TenderResponse{}, err
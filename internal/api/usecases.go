@@ -0,0 +1,106 @@
+package api
+
+import (
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	trmcontext "github.com/avito-tech/go-transaction-manager/trm/v2/context"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+
+	"avito-tenders/internal/api/attachments"
+	attachmentsRepo "avito-tenders/internal/api/attachments/repository"
+	attachmentsUsecase "avito-tenders/internal/api/attachments/usecase"
+	"avito-tenders/internal/api/bids"
+	bidsRepo "avito-tenders/internal/api/bids/repository"
+	bidsUsecase "avito-tenders/internal/api/bids/usecase"
+	"avito-tenders/internal/api/employee"
+	empRepo "avito-tenders/internal/api/employee/repository"
+	"avito-tenders/internal/api/graphql"
+	"avito-tenders/internal/api/organization"
+	orgRepo "avito-tenders/internal/api/organization/repository"
+	orgUsecase "avito-tenders/internal/api/organization/usecase"
+	"avito-tenders/internal/api/suppliers"
+	suppliersRepo "avito-tenders/internal/api/suppliers/repository"
+	suppliersUsecase "avito-tenders/internal/api/suppliers/usecase"
+	"avito-tenders/internal/api/tenders"
+	tendersRepo "avito-tenders/internal/api/tenders/repository"
+	tendersUsecase "avito-tenders/internal/api/tenders/usecase"
+	"avito-tenders/pkg/backend"
+	"avito-tenders/pkg/metrics"
+	"avito-tenders/pkg/tracing"
+)
+
+// usecases contains usecases wrapped with tracing, HTTP and gRPC APIs are built on top of them. GraphQL API reads
+// repositories directly to batch loads.
+type usecases struct {
+	tenders     tenders.Usecase
+	bids        bids.Usecase
+	attachments attachments.Usecase
+	suppliers   suppliers.Usecase
+	orgs        organization.Usecase
+	empRepo     employee.Repository
+	repos       graphql.Opts
+}
+
+func newUsecases(b backend.Backend) usecases {
+	tendersRepository := tendersRepo.NewRepository(b.DB, trmsqlx.DefaultCtxGetter)
+	organizationRepository := orgRepo.NewRepository(b.DB, trmsqlx.DefaultCtxGetter)
+	bidsRepository := bidsRepo.NewRepository(b.DB, trmsqlx.DefaultCtxGetter)
+	empRepository := empRepo.NewRepository(b.DB, trmsqlx.DefaultCtxGetter)
+	attachmentsRepository := attachmentsRepo.NewRepository(b.DB, trmsqlx.DefaultCtxGetter)
+	suppliersRepository := suppliersRepo.NewRepository(b.DB, trmsqlx.DefaultCtxGetter)
+
+	trManager := manager.Must(
+		metrics.InstrumentTrFactory(tracing.InstrumentTrFactory(trmsqlx.NewDefaultFactory(b.DB))),
+		manager.WithCtxManager(trmcontext.DefaultManager),
+	)
+
+	tendersUC := tendersUsecase.NewUsecase(tendersUsecase.Opts{
+		Repo:       tendersRepository,
+		OrgRepo:    organizationRepository,
+		TrManager:  trManager,
+		EmpRepo:    empRepository,
+		AttachRepo: attachmentsRepository,
+	})
+	bidsUC := bidsUsecase.NewUsecase(bidsUsecase.Opts{
+		Repo:         bidsRepository,
+		OrgRepo:      organizationRepository,
+		EmpRepo:      empRepository,
+		TenderRepo:   tendersRepository,
+		AttachRepo:   attachmentsRepository,
+		SupplierRepo: suppliersRepository,
+		TrManager:    trManager,
+	})
+	attachmentsUC := attachmentsUsecase.NewUsecase(attachmentsUsecase.Opts{
+		Repo:           attachmentsRepository,
+		TenderRepo:     tendersRepository,
+		BidsRepo:       bidsRepository,
+		OrgRepo:        organizationRepository,
+		EmpRepo:        empRepository,
+		BidPermissions: bidsUC,
+		Storage:        b.Storage,
+		Limits:         b.AttachmentLimits,
+		TrManager:      trManager,
+	})
+
+	suppliersUC := suppliersUsecase.NewUsecase(suppliersUsecase.Opts{
+		Repo: suppliersRepository,
+	})
+	orgUC := orgUsecase.NewUsecase(orgUsecase.Opts{
+		Repo:    organizationRepository,
+		EmpRepo: empRepository,
+	})
+
+	return usecases{
+		tenders:     tendersUsecase.NewTracingUsecase(tendersUC),
+		bids:        bidsUsecase.NewTracingUsecase(bidsUC),
+		attachments: attachmentsUsecase.NewTracingUsecase(attachmentsUC),
+		suppliers:   suppliersUsecase.NewTracingUsecase(suppliersUC),
+		orgs:        orgUsecase.NewTracingUsecase(orgUC),
+		empRepo:     empRepository,
+		repos: graphql.Opts{
+			TenderRepo: tendersRepository,
+			BidRepo:    bidsRepository,
+			OrgRepo:    organizationRepository,
+			EmpRepo:    empRepository,
+		},
+	}
+}
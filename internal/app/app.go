@@ -1,33 +1,113 @@
 package app
 
 import (
+	"context"
 	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"avito-tenders/config"
 	"avito-tenders/internal/api"
 	"avito-tenders/pkg/backend"
+	"avito-tenders/pkg/grpcserver"
+	"avito-tenders/pkg/health"
 	"avito-tenders/pkg/httpserver"
+	"avito-tenders/pkg/metrics"
+	"avito-tenders/pkg/tracing"
 )
 
+const tracingShutdownTimeout = 5 * time.Second
+
 // Run creates objects via constructors.
 func Run(cfg *config.Config) {
+	if err := setupLogger(cfg); err != nil {
+		log.Fatalf("Failed to initialize logger: %v", err)
+	}
+
+	slog.Info("effective config", "config", cfg.Dump())
+
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Opts{
+		Exporter:     cfg.TracingExporter,
+		ServiceName:  cfg.TracingServiceName,
+		OTLPEndpoint: cfg.TracingOTLPEndpoint,
+		OTLPInsecure: cfg.TracingOTLPInsecure,
+		SampleRatio:  cfg.TracingSampleRatio,
+	})
+	if err != nil {
+		log.Fatalf("Failed to initialize tracing: %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+
+		if err := shutdownTracing(ctx); err != nil {
+			log.Printf("app - Run - shutdownTracing: %s", err)
+		}
+	}()
+
 	back, err := backend.NewForServer(cfg)
 	if err != nil {
 		log.Fatalf("Failed to initialize backend: %v", err)
 	}
 	defer back.DB.Close()
 
-	Migrate(back)
+	if cfg.MigrateOnStart {
+		migrator, err := NewMigrator(back.DB.DB)
+		if err != nil {
+			log.Fatalf("Failed to initialize migrations: %v", err)
+		}
+
+		if err := migrator.Up(); err != nil {
+			log.Fatalf("Failed to apply migrations: %v", err)
+		}
+	}
+
+	expectedVersion, err := LatestMigrationVersion()
+	if err != nil {
+		log.Fatalf("Failed to read migrations: %v", err)
+	}
+	back.Health.Register("migrations", health.MigrationsCheck(back.DB.DB, expectedVersion))
 
 	routes, err := api.InitAPIRoutes(back)
 	if err != nil {
 		log.Panicf("Failed to initialize API routes: %v", err)
 	}
 
-	server := httpserver.New(routes, httpserver.Address(cfg.ServerAddress))
+	if err := metrics.RegisterDB(back.DB.DB, cfg.PostgresDatabase); err != nil {
+		log.Panicf("Failed to register DB metrics: %v", err)
+	}
+
+	server := httpserver.New(routes,
+		httpserver.Address(cfg.ServerAddress),
+		httpserver.ReadTimeout(cfg.ServerReadTimeout),
+		httpserver.WriteTimeout(cfg.ServerWriteTimeout),
+		httpserver.ShutdownTimeout(cfg.ServerShutdownTimeout),
+		httpserver.OnShutdown(back.Health.StartDraining),
+		httpserver.DrainDelay(cfg.ServerDrainDelay),
+	)
+
+	// Metrics are served by separate listener, so they are not exposed with public API.
+	var metricsNotify <-chan error
+	var metricsServer *httpserver.Server
+	if cfg.MetricsAddress != "" {
+		metricsServer = httpserver.New(metrics.Handler(), httpserver.Address(cfg.MetricsAddress))
+		metricsNotify = metricsServer.Notify()
+		back.Health.Register("metrics-server", health.WorkerCheck(metricsServer.Running))
+	}
+
+	var grpcNotify <-chan error
+	var grpcServer *grpcserver.Server
+	if cfg.GRPCAddress != "" {
+		grpcServer = grpcserver.New(api.InitGRPCServer(back),
+			grpcserver.Address(cfg.GRPCAddress),
+			grpcserver.ShutdownTimeout(cfg.ServerShutdownTimeout),
+		)
+		grpcNotify = grpcServer.Notify()
+		back.Health.Register("grpc-server", health.WorkerCheck(grpcServer.Running))
+	}
 
 	// Waiting signal
 	interrupt := make(chan os.Signal, 1)
@@ -38,6 +118,10 @@ func Run(cfg *config.Config) {
 		log.Printf("Received signal \"%v\", shutting down", s)
 	case err = <-server.Notify():
 		log.Printf("Received error, shutting down: %s", err)
+	case err = <-metricsNotify:
+		log.Printf("Received metrics server error, shutting down: %s", err)
+	case err = <-grpcNotify:
+		log.Printf("Received gRPC server error, shutting down: %s", err)
 	}
 
 	// Shutdown
@@ -45,4 +129,14 @@ func Run(cfg *config.Config) {
 	if err != nil {
 		log.Printf("app - Run - httpServer.Shutdown: %s", err)
 	}
+
+	if grpcServer != nil {
+		grpcServer.Shutdown()
+	}
+
+	if metricsServer != nil {
+		if err := metricsServer.Shutdown(); err != nil {
+			log.Printf("app - Run - metricsServer.Shutdown: %s", err)
+		}
+	}
 }
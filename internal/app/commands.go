@@ -0,0 +1,211 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"avito-tenders/config"
+	"avito-tenders/internal/seed"
+	"avito-tenders/pkg/postgres"
+)
+
+const migrateUsage = `usage: webserver migrate <command>
+
+commands:
+  up              apply all pending migrations
+  down [N]        roll back N migrations, 1 by default
+  status          print current version and pending migrations
+  force VERSION   set version without running migrations, used to fix dirty state
+  create NAME     create empty up and down migrations in -dir`
+
+// ErrUsage is returned when command arguments are invalid.
+var ErrUsage = errors.New("invalid arguments")
+
+// RunMigrate runs migrate subcommand with the given arguments.
+func RunMigrate(args []string) error {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	dir := flags.String("dir", "migrations", "directory for new migrations, used by create")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), migrateUsage)
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return ErrUsage
+	}
+
+	args = flags.Args()
+	if len(args) == 0 {
+		flags.Usage()
+		return ErrUsage
+	}
+
+	command, args := args[0], args[1:]
+
+	// Creating migration does not need the database.
+	if command == "create" {
+		if len(args) != 1 {
+			flags.Usage()
+			return ErrUsage
+		}
+
+		files, err := CreateMigration(*dir, args[0], time.Now())
+		if err != nil {
+			return err
+		}
+
+		for _, file := range files {
+			fmt.Println(file)
+		}
+
+		return nil
+	}
+
+	migrator, err := newMigratorFromConfig()
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	switch {
+	case command == "up" && len(args) == 0:
+		return migrator.Up()
+	case command == "down" && len(args) <= 1:
+		steps := 1
+		if len(args) == 1 {
+			steps, err = strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid number of steps %q", args[0])
+			}
+		}
+
+		return migrator.Down(steps)
+	case command == "force" && len(args) == 1:
+		version, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[0])
+		}
+
+		return migrator.Force(version)
+	case command == "status" && len(args) == 0:
+		status, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+
+		printMigrationStatus(status)
+
+		return nil
+	default:
+		flags.Usage()
+		return ErrUsage
+	}
+}
+
+// RunSeed runs seed subcommand with the given arguments.
+func RunSeed(args []string) error {
+	opts := seed.DefaultOpts
+
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	flags.Uint64Var(&opts.Seed, "seed", opts.Seed, "random seed, the same seed generates the same data")
+	flags.IntVar(&opts.Employees, "employees", opts.Employees, "number of employees")
+	flags.IntVar(&opts.Organizations, "organizations", opts.Organizations, "number of organizations")
+	flags.IntVar(&opts.MaxMembers, "max-members", opts.MaxMembers, "maximum responsible employees per organization")
+	flags.IntVar(&opts.TendersPerOrganization, "tenders", opts.TendersPerOrganization, "tenders per organization")
+	flags.IntVar(&opts.MaxBidsPerTender, "max-bids", opts.MaxBidsPerTender, "maximum bids per tender")
+	flags.Float64Var(&opts.ReviewRatio, "review-ratio", opts.ReviewRatio, "share of bids with review")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: webserver seed [flags]")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil || flags.NArg() != 0 {
+		return ErrUsage
+	}
+
+	ds, err := seed.Generate(opts)
+	if err != nil {
+		return err
+	}
+
+	db, err := newDBFromConfig()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := seed.Insert(ctx, tx, ds); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	log.Printf("Seed: %d employees, %d organizations, %d memberships, %d tenders, %d bids, %d approvals, %d reviews",
+		len(ds.Employees), len(ds.Organizations), len(ds.Memberships), len(ds.Tenders), len(ds.Bids),
+		len(ds.Approvals), len(ds.Reviews))
+
+	return nil
+}
+
+func newDBFromConfig() (*sqlx.DB, error) {
+	cfg, err := config.NewConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return postgres.New(&postgres.Opts{
+		Host:     cfg.PostgresHost,
+		Port:     cfg.PostgresPort,
+		Name:     cfg.PostgresDatabase,
+		Username: cfg.PostgresUsername,
+		Password: cfg.PostgresPassword,
+	})
+}
+
+func newMigratorFromConfig() (*Migrator, error) {
+	db, err := newDBFromConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	migrator, err := NewMigrator(db.DB)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return migrator, nil
+}
+
+func printMigrationStatus(status MigrationStatus) {
+	fmt.Printf("version: %d\n", status.Version)
+	fmt.Printf("dirty:   %t\n", status.Dirty)
+	fmt.Printf("latest:  %d\n", status.Latest)
+
+	if len(status.Pending) == 0 {
+		fmt.Println("pending: none")
+		return
+	}
+
+	fmt.Println("pending:")
+	for _, v := range status.Pending {
+		fmt.Printf("  %d\n", v)
+	}
+}
@@ -0,0 +1,21 @@
+package app
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"avito-tenders/config"
+)
+
+// setupLogger makes JSON logger with configured level the default one.
+func setupLogger(cfg *config.Config) error {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.LogLevel)); err != nil {
+		return fmt.Errorf("invalid log level %q: %w", cfg.LogLevel, err)
+	}
+
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: level})))
+
+	return nil
+}
@@ -1,75 +1,209 @@
 package app
 
 import (
+	"database/sql"
 	"errors"
+	"fmt"
 	"log"
+	"os"
+	"path/filepath"
+	"regexp"
 	"time"
 
-	"avito-tenders/pkg/backend"
-
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
 
-	// File driver for migration tool.
-	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"avito-tenders/migrations"
 )
 
-const (
-	defaultAttempts = 20
-	defaultTimeout  = time.Second
-)
+const migrationVersionLayout = "20060102150405"
+
+var migrationNameRe = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// MigrationStatus describes applied and pending migrations.
+type MigrationStatus struct {
+	Version uint
+	Dirty   bool
+	Latest  uint
+	Pending []uint
+}
 
-func Migrate(backend backend.Backend) {
-	var (
-		attempts = defaultAttempts
-		err      error
-		m        *migrate.Migrate
-	)
+// Migrator applies migrations embedded into the binary.
+type Migrator struct {
+	m *migrate.Migrate
+}
 
-	driver, err := postgres.WithInstance(backend.DB.DB, &postgres.Config{})
+// NewMigrator creates migrator for the database.
+func NewMigrator(db *sql.DB) (*Migrator, error) {
+	driver, err := postgres.WithInstance(db, &postgres.Config{})
 	if err != nil {
-		log.Fatalf("Could not create driver to database: %s", err)
+		return nil, fmt.Errorf("could not create database driver: %w", err)
 	}
 
-	for attempts > 0 {
-		m, err = migrate.NewWithDatabaseInstance("file://migrations", "postgres", driver)
-		if err == nil {
-			break
-		}
+	src, err := newMigrationsSource()
+	if err != nil {
+		return nil, err
+	}
 
-		log.Printf("Migrate: could not connect to database: %s, attempts lefr: %d", err, attempts)
-		time.Sleep(defaultTimeout)
-		attempts--
+	m, err := migrate.NewWithInstance("iofs", src, "postgres", driver)
+	if err != nil {
+		return nil, fmt.Errorf("could not create migrate instance: %w", err)
 	}
 
+	return &Migrator{m: m}, nil
+}
+
+// Up applies all pending migrations.
+func (m *Migrator) Up() error {
+	err := m.m.Up()
+	if errors.Is(err, migrate.ErrNoChange) {
+		log.Printf("Migrate: no change")
+		return nil
+	}
 	if err != nil {
-		log.Fatalf("Migrate: postgres connect error: %s", err)
+		return fmt.Errorf("up error: %w", err)
 	}
 
-	if m == nil {
-		log.Fatal("Migrate is nil")
+	log.Printf("Migrate: up success")
+
+	return nil
+}
+
+// Down rolls back the given number of migrations.
+func (m *Migrator) Down(steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("steps must be positive, got %d", steps)
 	}
 
-	_, _, err = m.Version()
+	err := m.m.Steps(-steps)
+	if errors.Is(err, migrate.ErrNoChange) {
+		log.Printf("Migrate: no change")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("down error: %w", err)
+	}
+
+	log.Printf("Migrate: down %d success", steps)
+
+	return nil
+}
+
+// Force sets version without running migrations, it is used to recover from a dirty state.
+func (m *Migrator) Force(version int) error {
+	if err := m.m.Force(version); err != nil {
+		return fmt.Errorf("force error: %w", err)
+	}
+
+	log.Printf("Migrate: forced version %d", version)
+
+	return nil
+}
+
+// Status returns current version and migrations that are not applied yet.
+func (m *Migrator) Status() (MigrationStatus, error) {
+	var status MigrationStatus
+
+	version, dirty, err := m.m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return status, fmt.Errorf("could not read version: %w", err)
+	}
+	status.Version = version
+	status.Dirty = dirty
+
+	versions, err := migrationVersions()
 	if err != nil {
-		// Skip first migration
-		if errors.Is(err, migrate.ErrNilVersion) {
-			log.Printf("Migrate: no migration found. Set force version")
-			if err := m.Force(20240909113647); err != nil {
-				log.Fatalf("Migrate: could not force migration: %s", err)
-			}
+		return status, err
+	}
+
+	for _, v := range versions {
+		if v > status.Version {
+			status.Pending = append(status.Pending, v)
 		}
 	}
+	status.Latest = versions[len(versions)-1]
+
+	return status, nil
+}
 
-	err = m.Up()
-	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
-		log.Fatalf("Migrate: up error: %s", err)
+// Close releases source and database driver, the database passed to NewMigrator is closed too.
+func (m *Migrator) Close() error {
+	srcErr, dbErr := m.m.Close()
+
+	return errors.Join(srcErr, dbErr)
+}
+
+// LatestMigrationVersion returns version of the last migration shipped with the application.
+func LatestMigrationVersion() (uint, error) {
+	versions, err := migrationVersions()
+	if err != nil {
+		return 0, err
 	}
 
-	if errors.Is(err, migrate.ErrNoChange) {
-		log.Printf("Migrate: no change")
-		return
+	return versions[len(versions)-1], nil
+}
+
+// CreateMigration writes empty up and down migrations with the current timestamp version into dir.
+func CreateMigration(dir, name string, now time.Time) ([]string, error) {
+	if !migrationNameRe.MatchString(name) {
+		return nil, fmt.Errorf("invalid migration name %q, use lowercase letters, digits, '-' and '_'", name)
 	}
 
-	log.Printf("Migrate: up success")
+	version := now.UTC().Format(migrationVersionLayout)
+
+	files := make([]string, 0, 2)
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(dir, fmt.Sprintf("%s_%s.%s.sql", version, name, direction))
+
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("could not create migration: %w", err)
+		}
+		if err := f.Close(); err != nil {
+			return nil, fmt.Errorf("could not create migration: %w", err)
+		}
+
+		files = append(files, path)
+	}
+
+	return files, nil
+}
+
+func newMigrationsSource() (source.Driver, error) {
+	src, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return nil, fmt.Errorf("could not open migrations source: %w", err)
+	}
+
+	return src, nil
+}
+
+// migrationVersions returns versions of embedded migrations in ascending order.
+func migrationVersions() ([]uint, error) {
+	src, err := newMigrationsSource()
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	version, err := src.First()
+	if err != nil {
+		return nil, fmt.Errorf("could not read first migration: %w", err)
+	}
+
+	versions := []uint{version}
+	for {
+		next, err := src.Next(version)
+		if errors.Is(err, os.ErrNotExist) {
+			return versions, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read migration after %d: %w", version, err)
+		}
+
+		versions = append(versions, next)
+		version = next
+	}
 }
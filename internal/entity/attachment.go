@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+// AttachmentOwnerType is enum that represents all entities that can have attachments.
+type AttachmentOwnerType string
+
+func (t AttachmentOwnerType) ValidationRule() validation.Rule {
+	return validation.In(
+		AttachmentTender,
+		AttachmentBid,
+		AttachmentReview,
+	)
+}
+
+const (
+	AttachmentTender AttachmentOwnerType = "tender"
+	AttachmentBid    AttachmentOwnerType = "bid"
+	AttachmentReview AttachmentOwnerType = "review"
+)
+
+// Attachment is the entity that represents file attached to tender, bid or review.
+// Attachment belongs to owner versions in range [VersionFrom, VersionTo), open range means current version.
+type Attachment struct {
+	ID          string              `json:"id" db:"id"`
+	OwnerType   AttachmentOwnerType `json:"ownerType" db:"owner_type"`
+	OwnerID     string              `json:"ownerId" db:"owner_id"`
+	FileName    string              `json:"fileName" db:"file_name"`
+	ContentType string              `json:"contentType" db:"content_type"`
+	Size        int64               `json:"size" db:"size"`
+	SHA256      string              `json:"sha256" db:"sha256"`
+	StorageKey  string              `json:"-" db:"storage_key"`
+	UploadedBy  string              `json:"uploadedBy" db:"uploaded_by"`
+	VersionFrom int                 `json:"versionFrom" db:"version_from"`
+	VersionTo   *int                `json:"versionTo" db:"version_to"`
+	CreatedAt   time.Time           `json:"createdAt" db:"created_at"`
+}
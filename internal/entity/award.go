@@ -0,0 +1,34 @@
+package entity
+
+import "time"
+
+// AwardAction is enum that represents ways the award is withdrawn from the bid.
+type AwardAction string
+
+const (
+	AwardDeclined  AwardAction = "Declined"
+	AwardCancelled AwardAction = "Cancelled"
+)
+
+// AwardWithdrawal is the award withdrawn from the bid and the runner-up bid offered the award instead, if any.
+// TenderVersion is the version of the tender when the runner-up was offered the award.
+type AwardWithdrawal struct {
+	ID            string      `db:"id"`
+	BidID         string      `db:"bid_id"`
+	TenderID      string      `db:"tender_id"`
+	Action        AwardAction `db:"action"`
+	Reason        *string     `db:"reason"`
+	ActorID       string      `db:"actor_id"`
+	OfferedBidID  *string     `db:"offered_bid_id"`
+	TenderVersion *int        `db:"tender_version"`
+	CreatedAt     time.Time   `db:"created_at"`
+}
+
+// AwardSettings configure what happens to competing bids when the tender awards a bid.
+type AwardSettings struct {
+	TenderID string `db:"tender_id"`
+	// RejectCompetingBids rejects published bids that can no longer win once the bid is awarded.
+	RejectCompetingBids bool `db:"reject_competing_bids"`
+	// RejectionFeedback is left as review on every rejected bid, if set.
+	RejectionFeedback *string `db:"rejection_feedback"`
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/invopop/validation"
+	"github.com/lib/pq"
 )
 
 type BidStatus string
@@ -14,7 +15,10 @@ func (s BidStatus) ValidationRule() validation.Rule {
 		BidPublished,
 		BidCanceled,
 		BidApproved,
-		BidRejected)
+		BidRejected,
+		BidOffered,
+		BidDeclined,
+		BidRevoked)
 }
 
 const (
@@ -23,8 +27,19 @@ const (
 	BidCanceled  BidStatus = "Canceled"
 	BidApproved  BidStatus = "Approved"
 	BidRejected  BidStatus = "Rejected"
+	// BidOffered is the runner-up bid offered the award after the winner withdrew.
+	BidOffered BidStatus = "Offered"
+	// BidDeclined is the awarded bid whose author declined the award.
+	BidDeclined BidStatus = "Declined"
+	// BidRevoked is the awarded bid whose award was cancelled by the tender organization.
+	BidRevoked BidStatus = "Revoked"
 )
 
+// IsAwarded reports whether the bid holds the award or is offered it.
+func (s BidStatus) IsAwarded() bool {
+	return s == BidApproved || s == BidOffered
+}
+
 type AuthorType string
 
 func (t AuthorType) ValidationRule() validation.Rule {
@@ -44,12 +59,15 @@ func (s BidDecision) ValidationRule() validation.Rule {
 	return validation.In(
 		DecisionApproved,
 		DecisionRejected,
+		DecisionAbstain,
 	)
 }
 
 const (
 	DecisionApproved BidDecision = "Approved"
 	DecisionRejected BidDecision = "Rejected"
+	// DecisionAbstain is counted in votes, but does not bring the bid closer to approval or rejection.
+	DecisionAbstain BidDecision = "Abstain"
 )
 
 type Bid struct {
@@ -62,4 +80,10 @@ type Bid struct {
 	AuthorID    string     `json:"authorId" db:"author_id"`
 	Version     int        `json:"version" db:"version"`
 	CreatedAt   time.Time  `json:"createdAt" db:"created_at"`
+
+	// OrganizationID is the organization the bid is made on behalf of, it is set for organization bids only.
+	OrganizationID *string `json:"organizationId,omitempty" db:"organization_id"`
+
+	// LotIDs contains lots of multi-lot tender that bid targets.
+	LotIDs pq.StringArray `json:"lotIds,omitempty" db:"lot_ids"`
 }
@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// RelatedParty is the user or the organization declared as related by the organization, it can't bid on tenders of
+// the organization.
+type RelatedParty struct {
+	OrganizationID string     `db:"organization_id"`
+	PartyType      AuthorType `db:"party_type"`
+	PartyID        string     `db:"party_id"`
+	CreatedAt      time.Time  `db:"created_at"`
+}
+
+// ConflictReason is enum that represents why the bid conflicts with interests of the tender organization.
+type ConflictReason string
+
+const (
+	ConflictOwnOrganization ConflictReason = "OwnOrganization"
+	ConflictRelatedParty    ConflictReason = "RelatedParty"
+)
+
+// ConflictAttempt is the bid refused because of conflict of interest.
+type ConflictAttempt struct {
+	ID             string         `db:"id"`
+	TenderID       string         `db:"tender_id"`
+	OrganizationID string         `db:"organization_id"`
+	AuthorType     AuthorType     `db:"author_type"`
+	AuthorID       string         `db:"author_id"`
+	Reason         ConflictReason `db:"reason"`
+	CreatedAt      time.Time      `db:"created_at"`
+}
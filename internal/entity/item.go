@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TenderItem is the entity that represents line item of the tender's bill of quantities.
+type TenderItem struct {
+	ID          string          `json:"id" db:"id"`
+	TenderID    string          `json:"tenderId" db:"tender_id"`
+	Description string          `json:"description" db:"description"`
+	Unit        string          `json:"unit" db:"unit"`
+	Quantity    decimal.Decimal `json:"quantity" db:"quantity"`
+	Version     int             `json:"version" db:"version"`
+	CreatedAt   time.Time       `json:"createdAt" db:"created_at"`
+}
+
+// BidItem is the entity that represents unit price quoted by bid for tender's line item.
+type BidItem struct {
+	ID           string          `json:"id" db:"id"`
+	BidID        string          `json:"bidId" db:"bid_id"`
+	TenderItemID string          `json:"tenderItemId" db:"tender_item_id"`
+	UnitPrice    decimal.Decimal `json:"unitPrice" db:"unit_price"`
+	Version      int             `json:"version" db:"version"`
+	CreatedAt    time.Time       `json:"createdAt" db:"created_at"`
+}
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+// LotStatus is enum that represents all possible tender lot statuses.
+type LotStatus string
+
+func (s LotStatus) ValidationRule() validation.Rule {
+	return validation.In(
+		LotOpen,
+		LotAwarded,
+		LotCancelled,
+	)
+}
+
+func (s LotStatus) String() string {
+	return string(s)
+}
+
+const (
+	LotOpen      LotStatus = "Open"
+	LotAwarded   LotStatus = "Awarded"
+	LotCancelled LotStatus = "Cancelled"
+)
+
+// IsFinal reports whether lot can no longer receive bids or awards.
+func (s LotStatus) IsFinal() bool {
+	return s == LotAwarded || s == LotCancelled
+}
+
+// TenderLot is the entity that represents a separately awarded part of the tender.
+type TenderLot struct {
+	ID           string    `json:"id" db:"id"`
+	TenderID     string    `json:"tenderId" db:"tender_id"`
+	Name         string    `json:"name" db:"name"`
+	Description  string    `json:"description" db:"description"`
+	Status       LotStatus `json:"status" db:"status"`
+	AwardedBidID *string   `json:"awardedBidId" db:"awarded_bid_id"`
+	Version      int       `json:"version" db:"version"`
+	CreatedAt    time.Time `json:"createdAt" db:"created_at"`
+}
@@ -20,7 +20,7 @@ type Organization struct {
 }
 
 type OrganizationResponsible struct {
-	ID             string
-	OrganizationID int
-	UserID         int
+	ID             string `db:"id"`
+	OrganizationID string `db:"organization_id"`
+	UserID         string `db:"user_id"`
 }
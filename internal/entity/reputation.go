@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// Reputation is the supplier rating aggregated over reviews on their bids. The supplier is the author of user bids or
+// the organization of the author of organization bids. Average ratings are nil until the first mark.
+type Reputation struct {
+	SupplierID    string     `db:"supplier_id"`
+	SupplierType  AuthorType `db:"supplier_type"`
+	ReviewsCount  int        `db:"reviews_count"`
+	RatingsCount  int        `db:"rating_count"`
+	Rating        *float64   `db:"rating"`
+	Quality       *float64   `db:"quality"`
+	Timeliness    *float64   `db:"timeliness"`
+	Communication *float64   `db:"communication"`
+	UpdatedAt     *time.Time `db:"updated_at"`
+}
@@ -1,10 +1,88 @@
 package entity
 
-import "time"
+import (
+	"time"
+
+	"github.com/invopop/validation"
+)
+
+// DisputeStatus is enum that represents states of the dispute of the review by the bid author.
+type DisputeStatus string
+
+const (
+	// DisputeOpen means the review is hidden until the tender organization arbitrates the dispute.
+	DisputeOpen DisputeStatus = "Open"
+	// DisputeAccepted means the review is removed: it stays hidden and does not affect reputation.
+	DisputeAccepted DisputeStatus = "Accepted"
+	// DisputeRejected means the review is shown again.
+	DisputeRejected DisputeStatus = "Rejected"
+)
+
+// DecisionRule is the rule for arbitration decisions, the dispute can be accepted or rejected only.
+func (s DisputeStatus) DecisionRule() validation.Rule {
+	return validation.In(
+		DisputeAccepted,
+		DisputeRejected,
+	)
+}
 
 type Review struct {
 	ID          string    `db:"id"`
 	Description string    `db:"description"`
 	BidID       string    `db:"bid_id"`
 	CreatedAt   time.Time `db:"created_at"`
+	ReviewRatings
+	// Reply is the answer of the bid author.
+	Reply         *string        `db:"reply"`
+	RepliedAt     *time.Time     `db:"replied_at"`
+	DisputeStatus *DisputeStatus `db:"dispute_status"`
+	DisputeReason *string        `db:"dispute_reason"`
+	Version       int            `db:"version"`
+	// Automatic review is the feedback left on rejection of competing bids, it is not counted in reputation.
+	Automatic bool `db:"automatic"`
+}
+
+// IsDisputed reports whether the bid author has ever disputed the review.
+func (r Review) IsDisputed() bool {
+	return r.DisputeStatus != nil
+}
+
+// ReviewRatings are optional marks from 1 to 5, the overall one and by categories.
+type ReviewRatings struct {
+	Rating        *int `db:"rating"`
+	Quality       *int `db:"quality_rating"`
+	Timeliness    *int `db:"timeliness_rating"`
+	Communication *int `db:"communication_rating"`
+}
+
+// ReviewsSummary is the reputation of the bids author based on their bids in all tenders.
+type ReviewsSummary struct {
+	BidsCount    int        `db:"bids_count"`
+	TendersCount int        `db:"tenders_count"`
+	ApprovedBids int        `db:"approved_bids"`
+	RejectedBids int        `db:"rejected_bids"`
+	ReviewsCount int        `db:"reviews_count"`
+	LastReviewAt *time.Time `db:"last_review_at"`
+}
+
+// ReviewAction is enum that represents actions of the review workflow recorded in the audit log.
+type ReviewAction string
+
+const (
+	ReviewCreated    ReviewAction = "Created"
+	ReviewReplied    ReviewAction = "Replied"
+	ReviewDisputed   ReviewAction = "Disputed"
+	ReviewArbitrated ReviewAction = "Arbitrated"
+)
+
+// ReviewAuditEntry is the action on the review and the version of the review it resulted in.
+type ReviewAuditEntry struct {
+	ID            string       `db:"id"`
+	ReviewID      string       `db:"review_id"`
+	Action        ReviewAction `db:"action"`
+	ActorID       string       `db:"actor_id"`
+	ActorUsername string       `db:"actor_username"`
+	Version       int          `db:"version"`
+	Comment       *string      `db:"comment"`
+	CreatedAt     time.Time    `db:"created_at"`
 }
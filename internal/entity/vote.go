@@ -0,0 +1,38 @@
+package entity
+
+import "time"
+
+// BidVote is the decision of the tender organization responsible on the bid. It can be changed or retracted until
+// the bid is approved or rejected.
+type BidVote struct {
+	BidID     string      `db:"bid_id"`
+	UserID    string      `db:"user_id"`
+	Username  string      `db:"username"`
+	Decision  BidDecision `db:"decision"`
+	Comment   *string     `db:"comment"`
+	CreatedAt time.Time   `db:"created_at"`
+	UpdatedAt time.Time   `db:"updated_at"`
+}
+
+// VoteTally is the number of votes by decision.
+type VoteTally struct {
+	Approved  int
+	Rejected  int
+	Abstained int
+}
+
+func TallyVotes(votes []BidVote) VoteTally {
+	var tally VoteTally
+	for _, vote := range votes {
+		switch vote.Decision {
+		case DecisionApproved:
+			tally.Approved++
+		case DecisionRejected:
+			tally.Rejected++
+		case DecisionAbstain:
+			tally.Abstained++
+		}
+	}
+
+	return tally
+}
@@ -0,0 +1,120 @@
+package seed
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// maxParams is the limit of bind parameters in one PostgreSQL statement.
+const maxParams = 65535
+
+// Insert writes dataset, rows that already exist are skipped. It should be called inside transaction.
+func Insert(ctx context.Context, db sqlx.ExecerContext, ds Dataset) error {
+	tables := []struct {
+		name string
+		rows any
+	}{
+		{"employee", ds.Employees},
+		{"organization", ds.Organizations},
+		{"organization_responsible", ds.Memberships},
+		{"tenders", ds.Tenders},
+		{"bids", ds.Bids},
+		{"bids_approvals", ds.Approvals},
+		{"bids_reviews", ds.Reviews},
+	}
+
+	for _, table := range tables {
+		if err := insertRows(ctx, db, table.name, table.rows); err != nil {
+			return fmt.Errorf("failed to insert %s: %w", table.name, err)
+		}
+	}
+
+	// Reputation is derived from reviews, so it is rebuilt instead of being generated.
+	if _, err := db.ExecContext(ctx, rebuildReputationQuery); err != nil {
+		return fmt.Errorf("failed to rebuild supplier reputation: %w", err)
+	}
+
+	return nil
+}
+
+const rebuildReputationQuery = `
+insert into supplier_reputation(supplier_id, supplier_type, reviews_count, rating_count, rating_sum, quality_count,
+                                quality_sum, timeliness_count, timeliness_sum, communication_count, communication_sum)
+select s.supplier_id, s.supplier_type, count(*),
+       count(s.rating), coalesce(sum(s.rating), 0),
+       count(s.quality_rating), coalesce(sum(s.quality_rating), 0),
+       count(s.timeliness_rating), coalesce(sum(s.timeliness_rating), 0),
+       count(s.communication_rating), coalesce(sum(s.communication_rating), 0)
+from (select case
+                 when b.author_type = 'Organization' then b.organization_id
+                 else b.author_id
+                 end       as supplier_id,
+             b.author_type as supplier_type,
+             br.rating, br.quality_rating, br.timeliness_rating, br.communication_rating
+      from bids_reviews br
+               join bids b on b.id = br.bid_id
+      where br.dispute_status is distinct from 'Accepted' and not br.automatic) s
+where s.supplier_id is not null
+group by s.supplier_id, s.supplier_type
+on conflict (supplier_id) do update set reviews_count       = excluded.reviews_count,
+                                        rating_count        = excluded.rating_count,
+                                        rating_sum          = excluded.rating_sum,
+                                        quality_count       = excluded.quality_count,
+                                        quality_sum         = excluded.quality_sum,
+                                        timeliness_count    = excluded.timeliness_count,
+                                        timeliness_sum      = excluded.timeliness_sum,
+                                        communication_count = excluded.communication_count,
+                                        communication_sum   = excluded.communication_sum,
+                                        updated_at          = now()`
+
+// insertRows inserts slice of structs in batches, columns are taken from db tags.
+func insertRows(ctx context.Context, db sqlx.ExecerContext, table string, rows any) error {
+	v := reflect.ValueOf(rows)
+	if v.Len() == 0 {
+		return nil
+	}
+
+	rowType := v.Type().Elem()
+	columns := make([]string, 0, rowType.NumField())
+	for i := 0; i < rowType.NumField(); i++ {
+		columns = append(columns, rowType.Field(i).Tag.Get("db"))
+	}
+
+	batchSize := maxParams / len(columns)
+	for start := 0; start < v.Len(); start += batchSize {
+		end := min(start+batchSize, v.Len())
+
+		var (
+			query strings.Builder
+			args  = make([]any, 0, (end-start)*len(columns))
+		)
+
+		fmt.Fprintf(&query, "insert into %s (%s) values ", table, strings.Join(columns, ", "))
+		for i := start; i < end; i++ {
+			if i > start {
+				query.WriteString(", ")
+			}
+
+			query.WriteString("(")
+			for j := range columns {
+				if j > 0 {
+					query.WriteString(", ")
+				}
+				args = append(args, v.Index(i).Field(j).Interface())
+				fmt.Fprintf(&query, "$%d", len(args))
+			}
+			query.WriteString(")")
+		}
+		query.WriteString(" on conflict do nothing")
+
+		if _, err := db.ExecContext(ctx, query.String(), args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package seed
+
+var firstNames = []string{
+	"Alexander", "Anna", "Dmitry", "Elena", "Ivan", "Maria", "Mikhail", "Olga", "Pavel", "Svetlana",
+	"Sergey", "Tatiana", "Andrey", "Natalia", "Nikolay", "Irina", "Alexey", "Ekaterina", "Vladimir", "Yulia",
+}
+
+var lastNames = []string{
+	"Ivanov", "Petrov", "Sidorov", "Smirnov", "Kuznetsov", "Popov", "Vasiliev", "Sokolov", "Mikhailov", "Novikov",
+	"Fedorov", "Morozov", "Volkov", "Alekseev", "Lebedev", "Semenov", "Egorov", "Pavlov", "Kozlov", "Stepanov",
+}
+
+var organizationAdjectives = []string{
+	"Northern", "Baltic", "Ural", "Siberian", "Volga", "Central", "Prime", "Global", "United", "Rapid",
+}
+
+var organizationNouns = []string{
+	"Logistics", "Builders", "Engineering", "Supply", "Industries", "Systems", "Trade", "Works", "Solutions", "Group",
+}
+
+var organizationTypes = []string{"IE", "LLC", "JSC"}
+
+// subjects are things tenders are announced for, grouped by service type.
+var subjects = map[string][]string{
+	"Construction": {"warehouse", "office building", "parking lot", "data center", "loading dock", "fence", "roof"},
+	"Delivery":     {"office furniture", "laptops", "printing paper", "packaging", "spare parts", "cleaning supplies"},
+	"Manufacture":  {"metal shelving", "branded merchandise", "wooden pallets", "server racks", "signage", "uniforms"},
+}
+
+var serviceTypes = []string{"Construction", "Delivery", "Manufacture"}
+
+var serviceVerbs = map[string]string{
+	"Construction": "Construction of",
+	"Delivery":     "Delivery of",
+	"Manufacture":  "Manufacture of",
+}
+
+var cities = []string{"Moscow", "Saint Petersburg", "Kazan", "Novosibirsk", "Yekaterinburg", "Nizhny Novgorod"}
+
+var bidPitches = []string{
+	"Fixed price, work starts within a week",
+	"Experienced team, references available on request",
+	"Lowest price with a two-year warranty",
+	"Turnkey delivery including installation",
+	"Flexible schedule, payment on completion",
+}
+
+var reviewTexts = []string{
+	"Delivered on time, quality as agreed",
+	"Good communication, minor delays",
+	"Excellent work, would hire again",
+	"Documents were incomplete, had to follow up",
+	"Price was fair, result met expectations",
+}
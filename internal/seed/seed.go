@@ -0,0 +1,394 @@
+// Package seed generates realistic demo data. The same options and seed always produce the same data, so generated
+// IDs are stable between runs and repeated seeding does not duplicate rows.
+package seed
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"avito-tenders/internal/entity"
+)
+
+// approvalQuorum is the maximum number of approvals needed to approve a bid, it matches bids usecase.
+const approvalQuorum = 3
+
+var baseTime = time.Date(2024, time.September, 1, 9, 0, 0, 0, time.UTC)
+
+// Opts represents volumes of generated data.
+type Opts struct {
+	Seed          uint64
+	Employees     int
+	Organizations int
+	// MaxMembers is the maximum number of responsible employees per organization, each organization has at least one.
+	MaxMembers int
+	// TendersPerOrganization is the number of tenders created by each organization.
+	TendersPerOrganization int
+	// MaxBidsPerTender is the maximum number of bids on published and closed tenders.
+	MaxBidsPerTender int
+	// ReviewRatio is the share of decided and published bids that have a review.
+	ReviewRatio float64
+}
+
+// DefaultOpts are volumes suitable for local demo.
+var DefaultOpts = Opts{
+	Seed:                   1,
+	Employees:              200,
+	Organizations:          20,
+	MaxMembers:             5,
+	TendersPerOrganization: 10,
+	MaxBidsPerTender:       6,
+	ReviewRatio:            0.3,
+}
+
+// Validate checks that requested volumes are consistent.
+func (o Opts) Validate() error {
+	switch {
+	case o.Employees <= 0 || o.Organizations < 0 || o.MaxMembers <= 0:
+		return fmt.Errorf("employees and max members must be positive, organizations must not be negative")
+	case o.TendersPerOrganization < 0 || o.MaxBidsPerTender < 0:
+		return fmt.Errorf("tenders and bids must not be negative")
+	case o.ReviewRatio < 0 || o.ReviewRatio > 1:
+		return fmt.Errorf("review ratio must be between 0 and 1")
+	case o.Organizations > o.Employees:
+		return fmt.Errorf("every organization needs an employee: %d organizations for %d employees",
+			o.Organizations, o.Employees)
+	}
+
+	return nil
+}
+
+type Employee struct {
+	ID        string    `db:"id"`
+	Username  string    `db:"username"`
+	FirstName string    `db:"first_name"`
+	LastName  string    `db:"last_name"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type Organization struct {
+	ID          string    `db:"id"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	Type        string    `db:"type"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+type Membership struct {
+	ID             string `db:"id"`
+	OrganizationID string `db:"organization_id"`
+	UserID         string `db:"user_id"`
+}
+
+type Tender struct {
+	ID              string              `db:"id"`
+	Name            string              `db:"name"`
+	Description     string              `db:"description"`
+	ServiceType     entity.ServiceType  `db:"service_type"`
+	Status          entity.TenderStatus `db:"status"`
+	OrganizationID  string              `db:"organization_id"`
+	CreatorUsername string              `db:"creator_username"`
+	CreatedAt       time.Time           `db:"created_at"`
+}
+
+type Bid struct {
+	ID             string            `db:"id"`
+	Name           string            `db:"name"`
+	Description    string            `db:"description"`
+	Status         entity.BidStatus  `db:"status"`
+	TenderID       string            `db:"tender_id"`
+	AuthorType     entity.AuthorType `db:"author_type"`
+	AuthorID       string            `db:"author_id"`
+	OrganizationID *string           `db:"organization_id"`
+	CreatedAt      time.Time         `db:"created_at"`
+}
+
+type Approval struct {
+	BidID  string `db:"bid_id"`
+	UserID string `db:"user_id"`
+}
+
+type Review struct {
+	ID                  string    `db:"id"`
+	Description         string    `db:"description"`
+	BidID               string    `db:"bid_id"`
+	CreatedAt           time.Time `db:"created_at"`
+	Rating              int       `db:"rating"`
+	QualityRating       int       `db:"quality_rating"`
+	TimelinessRating    int       `db:"timeliness_rating"`
+	CommunicationRating int       `db:"communication_rating"`
+}
+
+// Dataset is generated data in insertion order.
+type Dataset struct {
+	Employees     []Employee
+	Organizations []Organization
+	Memberships   []Membership
+	Tenders       []Tender
+	Bids          []Bid
+	Approvals     []Approval
+	Reviews       []Review
+}
+
+type generator struct {
+	r *rand.Rand
+	// orgMembers are employees responsible for the organization, memberOf is the reverse index.
+	orgMembers map[string][]Employee
+	memberOf   map[string]string
+}
+
+// Generate returns dataset for the options.
+func Generate(opts Opts) (Dataset, error) {
+	if err := opts.Validate(); err != nil {
+		return Dataset{}, err
+	}
+
+	g := &generator{
+		r:          rand.New(rand.NewPCG(opts.Seed, opts.Seed)),
+		orgMembers: map[string][]Employee{},
+		memberOf:   map[string]string{},
+	}
+
+	var ds Dataset
+	ds.Employees = g.employees(opts.Employees)
+	ds.Organizations = g.organizations(opts.Organizations)
+	ds.Memberships = g.memberships(ds.Employees, ds.Organizations, opts.MaxMembers)
+
+	for _, org := range ds.Organizations {
+		for range opts.TendersPerOrganization {
+			tender := g.tender(org)
+			ds.Tenders = append(ds.Tenders, tender)
+
+			if tender.Status == entity.TenderCreated {
+				continue
+			}
+
+			bids := g.bids(tender, ds.Employees, g.r.IntN(opts.MaxBidsPerTender+1))
+			for _, bid := range bids {
+				ds.Approvals = append(ds.Approvals, g.approvals(org, bid)...)
+
+				if bid.Status != entity.BidCreated && bid.Status != entity.BidCanceled && g.r.Float64() < opts.ReviewRatio {
+					ds.Reviews = append(ds.Reviews, g.review(bid))
+				}
+			}
+			ds.Bids = append(ds.Bids, bids...)
+		}
+	}
+
+	return ds, nil
+}
+
+func (g *generator) employees(n int) []Employee {
+	employees := make([]Employee, 0, n)
+	for i := range n {
+		first, last := pick(g.r, firstNames), pick(g.r, lastNames)
+		employees = append(employees, Employee{
+			ID:        g.uuid(),
+			Username:  fmt.Sprintf("%s.%s%d", strings.ToLower(first), strings.ToLower(last), i+1),
+			FirstName: first,
+			LastName:  last,
+			CreatedAt: baseTime,
+		})
+	}
+
+	return employees
+}
+
+func (g *generator) organizations(n int) []Organization {
+	organizations := make([]Organization, 0, n)
+	for range n {
+		noun := pick(g.r, organizationNouns)
+		organizations = append(organizations, Organization{
+			ID:          g.uuid(),
+			Name:        fmt.Sprintf("%s %s", pick(g.r, organizationAdjectives), noun),
+			Description: fmt.Sprintf("%s company from %s", noun, pick(g.r, cities)),
+			Type:        pick(g.r, organizationTypes),
+			CreatedAt:   baseTime,
+		})
+	}
+
+	return organizations
+}
+
+// memberships assigns every organization from 1 to maxMembers employees, the rest of employees stay independent.
+func (g *generator) memberships(employees []Employee, organizations []Organization, maxMembers int) []Membership {
+	var memberships []Membership
+
+	perm := g.r.Perm(len(employees))
+	next := 0
+	for i, org := range organizations {
+		// Leave at least one employee for each of the remaining organizations.
+		available := len(employees) - next - (len(organizations) - i - 1)
+		count := min(1+g.r.IntN(maxMembers), available)
+
+		for range count {
+			employee := employees[perm[next]]
+			next++
+
+			memberships = append(memberships, Membership{
+				ID:             g.uuid(),
+				OrganizationID: org.ID,
+				UserID:         employee.ID,
+			})
+			g.orgMembers[org.ID] = append(g.orgMembers[org.ID], employee)
+			g.memberOf[employee.ID] = org.ID
+		}
+	}
+
+	return memberships
+}
+
+func (g *generator) tender(org Organization) Tender {
+	serviceType := pick(g.r, serviceTypes)
+	subject := pick(g.r, subjects[serviceType])
+	city := pick(g.r, cities)
+
+	status := entity.TenderPublished
+	switch n := g.r.IntN(10); {
+	case n < 2:
+		status = entity.TenderCreated
+	case n < 4:
+		status = entity.TenderClosed
+	}
+
+	return Tender{
+		ID:              g.uuid(),
+		Name:            fmt.Sprintf("%s %s in %s", serviceVerbs[serviceType], subject, city),
+		Description:     fmt.Sprintf("%s is looking for a contractor: %s %s, %s.", org.Name, strings.ToLower(serviceVerbs[serviceType]), subject, city),
+		ServiceType:     entity.ServiceType(serviceType),
+		Status:          status,
+		OrganizationID:  org.ID,
+		CreatorUsername: pick(g.r, g.orgMembers[org.ID]).Username,
+		CreatedAt:       g.after(baseTime, 30*24*time.Hour),
+	}
+}
+
+// bids creates bids from employees outside of the tender organization. Closed tender has one approved bid, the others
+// are rejected or canceled.
+func (g *generator) bids(tender Tender, employees []Employee, n int) []Bid {
+	bids := make([]Bid, 0, n)
+	for i := 0; i < n; i++ {
+		author := pick(g.r, employees)
+		if g.memberOf[author.ID] == tender.OrganizationID {
+			continue
+		}
+
+		authorType := entity.AuthorUser
+		name := fmt.Sprintf("Offer from %s %s", author.FirstName, author.LastName)
+		var organizationID *string
+		if orgID, ok := g.memberOf[author.ID]; ok {
+			authorType = entity.AuthorOrganization
+			name = fmt.Sprintf("Offer from organization of %s %s", author.FirstName, author.LastName)
+			organizationID = &orgID
+		}
+
+		bids = append(bids, Bid{
+			ID:             g.uuid(),
+			Name:           name,
+			Description:    pick(g.r, bidPitches),
+			Status:         g.bidStatus(tender, len(bids) == 0),
+			TenderID:       tender.ID,
+			AuthorType:     authorType,
+			AuthorID:       author.ID,
+			OrganizationID: organizationID,
+			CreatedAt:      g.after(tender.CreatedAt, 7*24*time.Hour),
+		})
+	}
+
+	return bids
+}
+
+func (g *generator) bidStatus(tender Tender, first bool) entity.BidStatus {
+	n := g.r.IntN(10)
+
+	if tender.Status == entity.TenderClosed {
+		switch {
+		case first:
+			return entity.BidApproved
+		case n < 7:
+			return entity.BidRejected
+		default:
+			return entity.BidCanceled
+		}
+	}
+
+	switch {
+	case n < 2:
+		return entity.BidCreated
+	case n < 7:
+		return entity.BidPublished
+	case n < 8:
+		return entity.BidCanceled
+	default:
+		return entity.BidRejected
+	}
+}
+
+// approvals returns quorum of approvals for approved bid and fewer than quorum for published one.
+func (g *generator) approvals(org Organization, bid Bid) []Approval {
+	members := g.orgMembers[org.ID]
+	quorum := min(approvalQuorum, len(members))
+
+	var count int
+	switch bid.Status {
+	case entity.BidApproved:
+		count = quorum
+	case entity.BidPublished:
+		count = g.r.IntN(quorum)
+	default:
+		return nil
+	}
+
+	approvals := make([]Approval, 0, count)
+	for _, i := range g.r.Perm(len(members))[:count] {
+		approvals = append(approvals, Approval{BidID: bid.ID, UserID: members[i].ID})
+	}
+
+	return approvals
+}
+
+func (g *generator) review(bid Bid) Review {
+	return Review{
+		ID:                  g.uuid(),
+		Description:         pick(g.r, reviewTexts),
+		BidID:               bid.ID,
+		CreatedAt:           g.after(bid.CreatedAt, 14*24*time.Hour),
+		Rating:              g.rating(),
+		QualityRating:       g.rating(),
+		TimelinessRating:    g.rating(),
+		CommunicationRating: g.rating(),
+	}
+}
+
+// rating returns mark from 1 to 5, good marks are more likely.
+func (g *generator) rating() int {
+	return 5 - g.r.IntN(5)*g.r.IntN(2)
+}
+
+// uuid returns random UUID v4 taken from the seeded generator.
+func (g *generator) uuid() string {
+	var b [16]byte
+	for i := 0; i < len(b); i += 8 {
+		v := g.r.Uint64()
+		for j := 0; j < 8; j++ {
+			b[i+j] = byte(v >> (8 * j))
+		}
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return uuid.UUID(b).String()
+}
+
+// after returns random time in [t, t+d) truncated to seconds.
+func (g *generator) after(t time.Time, d time.Duration) time.Time {
+	return t.Add(time.Duration(g.r.Int64N(int64(d)))).Truncate(time.Second)
+}
+
+func pick[T any](r *rand.Rand, items []T) T {
+	return items[r.IntN(len(items))]
+}
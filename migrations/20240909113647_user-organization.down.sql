@@ -1,5 +1,5 @@
 DROP TABLE IF EXISTS organization_responsible;
 DROP TABLE IF EXISTS organization;
 DROP TABLE IF EXISTS employee;
-DROP TYPE organization_type;
-drop extension "uuid-ossp";
\ No newline at end of file
+DROP TYPE IF EXISTS organization_type;
+drop extension if exists "uuid-ossp";
\ No newline at end of file
@@ -1,6 +1,7 @@
+-- The tables and the type may be created in advance, so the migration is applied only to missing objects.
 CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 
-CREATE TABLE employee
+CREATE TABLE IF NOT EXISTS employee
 (
     id         uuid primary key default uuid_generate_v4(),
     username   VARCHAR(50) UNIQUE NOT NULL,
@@ -10,13 +11,20 @@ CREATE TABLE employee
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
-CREATE TYPE organization_type AS ENUM (
-    'IE',
-    'LLC',
-    'JSC'
-    );
+DO
+$$
+    BEGIN
+        CREATE TYPE organization_type AS ENUM (
+            'IE',
+            'LLC',
+            'JSC'
+            );
+    EXCEPTION
+        WHEN duplicate_object THEN NULL;
+    END
+$$;
 
-CREATE TABLE organization
+CREATE TABLE IF NOT EXISTS organization
 (
     id          uuid primary key default uuid_generate_v4(),
     name        VARCHAR(100) NOT NULL,
@@ -26,9 +34,9 @@ CREATE TABLE organization
     updated_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
-CREATE TABLE organization_responsible
+CREATE TABLE IF NOT EXISTS organization_responsible
 (
     id              uuid primary key default uuid_generate_v4(),
     organization_id uuid REFERENCES organization (id) ON DELETE CASCADE,
     user_id         uuid REFERENCES employee (id) ON DELETE CASCADE
-);
\ No newline at end of file
+);
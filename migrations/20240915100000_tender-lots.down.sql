@@ -0,0 +1,5 @@
+drop table bids_lots;
+drop trigger tender_lot_update_trigger on tender_lots;
+drop function log_tender_lot_update;
+drop table tender_lots_history;
+drop table tender_lots;
@@ -0,0 +1,51 @@
+create table tender_lots
+(
+    id             uuid primary key   default uuid_generate_v4(),
+    tender_id      uuid      not null references tenders (id),
+    name           text      not null,
+    description    text      not null,
+    status         text      not null default 'Open',
+    awarded_bid_id uuid references bids (id),
+    version        int       not null default 1,
+    created_at     timestamp not null default now()
+);
+
+CREATE TABLE tender_lots_history
+(
+    lot_id         uuid references tender_lots (id),
+    tender_id      uuid      not null references tenders (id),
+    name           text      not null,
+    description    text      not null,
+    status         text      not null,
+    awarded_bid_id uuid references bids (id),
+    version        int       not null,
+    created_at     timestamp not null,
+    modified_at    timestamp not null default now(),
+    primary key (lot_id, version)
+);
+
+CREATE OR REPLACE FUNCTION log_tender_lot_update() RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO tender_lots_history(lot_id, tender_id, name, description, status, awarded_bid_id, version, created_at)
+    VALUES (OLD.id, OLD.tender_id, OLD.name, OLD.description, OLD.status, OLD.awarded_bid_id, OLD.version,
+            OLD.created_at);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tender_lot_update_trigger
+    BEFORE UPDATE
+    ON tender_lots
+    FOR EACH ROW
+EXECUTE FUNCTION log_tender_lot_update();
+
+create table bids_lots
+(
+    bid_id       uuid not null references bids (id),
+    lot_id       uuid not null references tender_lots (id),
+    -- Bid targets the lot in bid versions in range [version_from, version_to).
+    version_from int  not null,
+    version_to   int,
+    primary key (bid_id, lot_id, version_from)
+);
@@ -0,0 +1,8 @@
+drop trigger bid_item_update_trigger on bid_items;
+drop function log_bid_item_update;
+drop table bid_items_history;
+drop table bid_items;
+drop trigger tender_item_update_trigger on tender_items;
+drop function log_tender_item_update;
+drop table tender_items_history;
+drop table tender_items;
@@ -0,0 +1,76 @@
+create table tender_items
+(
+    id          uuid primary key        default uuid_generate_v4(),
+    tender_id   uuid           not null references tenders (id),
+    description text           not null,
+    unit        text           not null,
+    quantity    numeric(18, 4) not null check (quantity > 0),
+    version     int            not null default 1,
+    created_at  timestamp      not null default now()
+);
+
+CREATE TABLE tender_items_history
+(
+    item_id     uuid references tender_items (id),
+    tender_id   uuid           not null references tenders (id),
+    description text           not null,
+    unit        text           not null,
+    quantity    numeric(18, 4) not null,
+    version     int            not null,
+    created_at  timestamp      not null,
+    modified_at timestamp      not null default now(),
+    primary key (item_id, version)
+);
+
+CREATE OR REPLACE FUNCTION log_tender_item_update() RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO tender_items_history(item_id, tender_id, description, unit, quantity, version, created_at)
+    VALUES (OLD.id, OLD.tender_id, OLD.description, OLD.unit, OLD.quantity, OLD.version, OLD.created_at);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tender_item_update_trigger
+    BEFORE UPDATE
+    ON tender_items
+    FOR EACH ROW
+EXECUTE FUNCTION log_tender_item_update();
+
+create table bid_items
+(
+    id             uuid primary key        default uuid_generate_v4(),
+    bid_id         uuid           not null references bids (id),
+    tender_item_id uuid           not null references tender_items (id),
+    unit_price     numeric(18, 2) not null check (unit_price >= 0),
+    version        int            not null default 1,
+    created_at     timestamp      not null default now(),
+    unique (bid_id, tender_item_id)
+);
+
+CREATE TABLE bid_items_history
+(
+    item_id        uuid references bid_items (id),
+    bid_id         uuid           not null references bids (id),
+    tender_item_id uuid           not null references tender_items (id),
+    unit_price     numeric(18, 2) not null,
+    version        int            not null,
+    created_at     timestamp      not null,
+    modified_at    timestamp      not null default now(),
+    primary key (item_id, version)
+);
+
+CREATE OR REPLACE FUNCTION log_bid_item_update() RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO bid_items_history(item_id, bid_id, tender_item_id, unit_price, version, created_at)
+    VALUES (OLD.id, OLD.bid_id, OLD.tender_item_id, OLD.unit_price, OLD.version, OLD.created_at);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER bid_item_update_trigger
+    BEFORE UPDATE
+    ON bid_items
+    FOR EACH ROW
+EXECUTE FUNCTION log_bid_item_update();
@@ -0,0 +1,18 @@
+create table attachments
+(
+    id           uuid primary key   default uuid_generate_v4(),
+    owner_type   text      not null,
+    owner_id     uuid      not null,
+    file_name    text      not null,
+    content_type text      not null,
+    size         bigint    not null,
+    sha256       text      not null,
+    storage_key  text      not null,
+    uploaded_by  uuid      not null references employee (id),
+    -- Attachment belongs to owner versions in range [version_from, version_to).
+    version_from int       not null,
+    version_to   int,
+    created_at   timestamp not null default now()
+);
+
+create index attachments_owner_idx on attachments (owner_type, owner_id);
@@ -0,0 +1,7 @@
+drop table supplier_reputation;
+
+alter table bids_reviews
+    drop column rating,
+    drop column quality_rating,
+    drop column timeliness_rating,
+    drop column communication_rating;
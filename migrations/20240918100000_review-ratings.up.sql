@@ -0,0 +1,38 @@
+alter table bids_reviews
+    add column rating               smallint check (rating between 1 and 5),
+    add column quality_rating       smallint check (quality_rating between 1 and 5),
+    add column timeliness_rating    smallint check (timeliness_rating between 1 and 5),
+    add column communication_rating smallint check (communication_rating between 1 and 5);
+
+-- Reputation is aggregated per supplier: the author of user bids and the organization of the author of
+-- organization bids. Sums and counts are kept separately because ratings are optional.
+create table supplier_reputation
+(
+    supplier_id         uuid primary key,
+    supplier_type       text      not null,
+    reviews_count       int       not null default 0,
+    rating_count        int       not null default 0,
+    rating_sum          int       not null default 0,
+    quality_count       int       not null default 0,
+    quality_sum         int       not null default 0,
+    timeliness_count    int       not null default 0,
+    timeliness_sum      int       not null default 0,
+    communication_count int       not null default 0,
+    communication_sum   int       not null default 0,
+    updated_at          timestamp not null default now()
+);
+
+insert into supplier_reputation(supplier_id, supplier_type, reviews_count)
+select s.supplier_id, s.supplier_type, count(*)
+from (select case
+                 when b.author_type = 'Organization' then (select r.organization_id
+                                                           from organization_responsible r
+                                                           where r.user_id = b.author_id
+                                                           limit 1)
+                 else b.author_id
+                 end       as supplier_id,
+             b.author_type as supplier_type
+      from bids_reviews br
+               join bids b on b.id = br.bid_id) s
+where s.supplier_id is not null
+group by s.supplier_id, s.supplier_type;
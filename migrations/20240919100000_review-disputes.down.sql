@@ -0,0 +1,12 @@
+drop table bids_reviews_audit;
+
+drop trigger review_update_trigger on bids_reviews;
+drop function log_review_update;
+drop table bids_reviews_history;
+
+alter table bids_reviews
+    drop column reply,
+    drop column replied_at,
+    drop column dispute_status,
+    drop column dispute_reason,
+    drop column version;
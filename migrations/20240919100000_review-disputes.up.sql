@@ -0,0 +1,58 @@
+alter table bids_reviews
+    add column reply          text,
+    add column replied_at     timestamp,
+    add column dispute_status text,
+    add column dispute_reason text,
+    add column version        int not null default 1;
+
+CREATE TABLE bids_reviews_history
+(
+    review_id            uuid references bids_reviews (id),
+    description          text,
+    bid_id               uuid,
+    rating               smallint,
+    quality_rating       smallint,
+    timeliness_rating    smallint,
+    communication_rating smallint,
+    reply                text,
+    replied_at           timestamp,
+    dispute_status       text,
+    dispute_reason       text,
+    version              int       not null,
+    created_at           timestamp not null,
+    modified_at          timestamp not null default now(),
+    primary key (review_id, version)
+);
+
+CREATE OR REPLACE FUNCTION log_review_update() RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO bids_reviews_history(review_id, description, bid_id, rating, quality_rating, timeliness_rating,
+                                     communication_rating, reply, replied_at, dispute_status, dispute_reason, version,
+                                     created_at)
+    VALUES (OLD.id, OLD.description, OLD.bid_id, OLD.rating, OLD.quality_rating, OLD.timeliness_rating,
+            OLD.communication_rating, OLD.reply, OLD.replied_at, OLD.dispute_status, OLD.dispute_reason, OLD.version,
+            OLD.created_at);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER review_update_trigger
+    BEFORE UPDATE
+    ON bids_reviews
+    FOR EACH ROW
+EXECUTE FUNCTION log_review_update();
+
+-- Audit log of the review workflow: who did what and which version of the review it resulted in.
+create table bids_reviews_audit
+(
+    id         uuid primary key   default uuid_generate_v4(),
+    review_id  uuid      not null references bids_reviews (id),
+    action     text      not null,
+    actor_id   uuid      not null references employee (id),
+    version    int       not null,
+    comment    text,
+    created_at timestamp not null default now()
+);
+
+create index bids_reviews_audit_review_idx on bids_reviews_audit (review_id, created_at);
@@ -0,0 +1,7 @@
+delete from bids_approvals where decision <> 'Approved';
+
+alter table bids_approvals
+    drop column decision,
+    drop column comment,
+    drop column created_at,
+    drop column updated_at;
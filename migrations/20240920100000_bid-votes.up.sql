@@ -0,0 +1,6 @@
+-- Approvals become votes: responsibles may approve, reject or abstain and change the vote until quorum is reached.
+alter table bids_approvals
+    add column decision   text      not null default 'Approved' check (decision in ('Approved', 'Rejected', 'Abstain')),
+    add column comment    text,
+    add column created_at timestamp not null default now(),
+    add column updated_at timestamp not null default now();
@@ -0,0 +1 @@
+drop table bids_award_withdrawals;
@@ -0,0 +1,16 @@
+-- Awards withdrawn after approval: declined by the winner or cancelled by the tender organization.
+create table bids_award_withdrawals
+(
+    id             uuid primary key   default uuid_generate_v4(),
+    bid_id         uuid      not null references bids (id),
+    tender_id      uuid      not null references tenders (id),
+    action         text      not null,
+    reason         text,
+    actor_id       uuid      not null references employee (id),
+    offered_bid_id uuid references bids (id),
+    -- Version of the tender when the runner-up was offered the award.
+    tender_version int,
+    created_at     timestamp not null default now()
+);
+
+create index bids_award_withdrawals_tender_idx on bids_award_withdrawals (tender_id, created_at);
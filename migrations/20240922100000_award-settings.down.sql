@@ -0,0 +1,4 @@
+alter table bids_reviews
+    drop column automatic;
+
+drop table tender_award_settings;
@@ -0,0 +1,12 @@
+-- Award settings of the tender, tenders without settings keep competing bids published on award.
+create table tender_award_settings
+(
+    tender_id             uuid primary key references tenders (id),
+    reject_competing_bids boolean   not null default false,
+    rejection_feedback    text,
+    updated_at            timestamp not null default now()
+);
+
+-- Automatic reviews are the rejection feedback left on competing bids, they are not counted in reputation.
+alter table bids_reviews
+    add column automatic boolean not null default false;
@@ -0,0 +1,2 @@
+drop table bid_conflict_attempts;
+drop table organization_related_parties;
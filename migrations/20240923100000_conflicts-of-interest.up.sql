@@ -0,0 +1,23 @@
+-- Parties declared by the organization as related: users and organizations that can't bid on its tenders.
+create table organization_related_parties
+(
+    organization_id uuid      not null references organization (id) on delete cascade,
+    party_type      text      not null,
+    party_id        uuid      not null,
+    created_at      timestamp not null default now(),
+    primary key (organization_id, party_type, party_id)
+);
+
+-- Bids refused because of conflict of interest, the bid itself is not created.
+create table bid_conflict_attempts
+(
+    id              uuid primary key   default uuid_generate_v4(),
+    tender_id       uuid      not null references tenders (id),
+    organization_id uuid      not null references organization (id),
+    author_type     text      not null,
+    author_id       uuid      not null references employee (id),
+    reason          text      not null,
+    created_at      timestamp not null default now()
+);
+
+create index bid_conflict_attempts_organization_idx on bid_conflict_attempts (organization_id, created_at);
@@ -0,0 +1,14 @@
+CREATE OR REPLACE FUNCTION log_bid_update() RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO bids_history(bid_id, name, description, status, tender_id, author_type, author_id, version, created_at)
+    VALUES (old.id, old.name, old.description, old.status, old.tender_id, old.author_type, old.author_id, old.version, old.created_at);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+alter table bids_history
+    drop column organization_id;
+
+alter table bids
+    drop column organization_id;
@@ -0,0 +1,37 @@
+-- Organization bids store the organization they are made on behalf of, authors may be responsible in several
+-- organizations.
+alter table bids
+    add column organization_id uuid references organization (id);
+
+alter table bids_history
+    add column organization_id uuid;
+
+-- Existing bids keep the organization they were resolved to before: the first organization of the author. Versions
+-- are not changed, so the history trigger is disabled.
+alter table bids disable trigger bid_update_trigger;
+
+update bids b
+set organization_id = (select r.organization_id
+                       from organization_responsible r
+                       where r.user_id = b.author_id
+                       limit 1)
+where b.author_type = 'Organization';
+
+alter table bids enable trigger bid_update_trigger;
+
+update bids_history h
+set organization_id = b.organization_id
+from bids b
+where b.id = h.bid_id
+  and h.author_type = 'Organization';
+
+CREATE OR REPLACE FUNCTION log_bid_update() RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO bids_history(bid_id, name, description, status, tender_id, author_type, author_id, organization_id,
+                             version, created_at)
+    VALUES (old.id, old.name, old.description, old.status, old.tender_id, old.author_type, old.author_id,
+            old.organization_id, old.version, old.created_at);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
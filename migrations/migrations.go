@@ -0,0 +1,9 @@
+// Package migrations embeds SQL migrations, so the binary does not depend on the working directory.
+package migrations
+
+import "embed"
+
+// FS contains golang-migrate migrations named <version>_<name>.{up,down}.sql.
+//
+//go:embed *.sql
+var FS embed.FS
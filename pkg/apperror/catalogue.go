@@ -0,0 +1,146 @@
+package apperror
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+)
+
+// Error codes are stable identifiers of errors that clients may rely on, unlike human-readable messages.
+const (
+	CodeInvalidInput             = "invalid_input"
+	CodeValidationFailed         = "validation_failed"
+	CodeUnauthorized             = "unauthorized"
+	CodeUserRequired             = "user_required"
+	CodeUserDoesNotExist         = "user_does_not_exist"
+	CodeOrganizationDoesNotExist = "organization_does_not_exist"
+	CodeForbidden                = "forbidden"
+	CodeConflictOfInterest       = "conflict_of_interest"
+	CodeNotFound                 = "not_found"
+	CodePayloadTooLarge          = "payload_too_large"
+	CodeInternal                 = "internal_error"
+)
+
+// problemTypePrefix is the prefix of RFC 7807 problem type, it points to the catalogue entry.
+const problemTypePrefix = "/api/errors#"
+
+// CatalogueEntry describes error that can be returned by API.
+type CatalogueEntry struct {
+	Code        string `json:"code"`
+	Status      int    `json:"status"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// Catalogue is the list of all errors that can be returned by API.
+var Catalogue = []CatalogueEntry{
+	{
+		Code:        CodeInvalidInput,
+		Status:      http.StatusBadRequest,
+		Title:       "Invalid input",
+		Description: "Request is malformed: body is not valid JSON, path or query parameter has wrong format.",
+	},
+	{
+		Code:        CodeValidationFailed,
+		Status:      http.StatusBadRequest,
+		Title:       "Validation failed",
+		Description: "Request is well-formed, but some fields violate constraints. Violations are listed in errors.",
+	},
+	{
+		Code:        CodeUnauthorized,
+		Status:      http.StatusUnauthorized,
+		Title:       "Unauthorized",
+		Description: "User can't be identified.",
+	},
+	{
+		Code:        CodeUserRequired,
+		Status:      http.StatusUnauthorized,
+		Title:       "User is required",
+		Description: "Username query parameter is required by the endpoint.",
+	},
+	{
+		Code:        CodeUserDoesNotExist,
+		Status:      http.StatusUnauthorized,
+		Title:       "User does not exist",
+		Description: "User with the given username or id is not found.",
+	},
+	{
+		Code:        CodeOrganizationDoesNotExist,
+		Status:      http.StatusUnauthorized,
+		Title:       "Organization does not exist",
+		Description: "User doesn't belong to any organization or organization is not found.",
+	},
+	{
+		Code:        CodeForbidden,
+		Status:      http.StatusForbidden,
+		Title:       "Forbidden",
+		Description: "User doesn't have enough permissions for the action.",
+	},
+	{
+		Code:        CodeConflictOfInterest,
+		Status:      http.StatusForbidden,
+		Title:       "Conflict of interest",
+		Description: "Author of the bid belongs to the tender organization or is declared as its related party.",
+	},
+	{
+		Code:        CodeNotFound,
+		Status:      http.StatusNotFound,
+		Title:       "Not found",
+		Description: "Requested entity is not found.",
+	},
+	{
+		Code:        CodePayloadTooLarge,
+		Status:      http.StatusRequestEntityTooLarge,
+		Title:       "Payload too large",
+		Description: "Uploaded content exceeds the size limit.",
+	},
+	{
+		Code:        CodeInternal,
+		Status:      http.StatusInternalServerError,
+		Title:       "Internal error",
+		Description: "Unexpected server error, request can be retried later.",
+	},
+}
+
+// sentinelCodes maps sentinel errors to codes more specific than the ones derived from status.
+var sentinelCodes = []struct {
+	err  error
+	code string
+}{
+	{err: ErrUserEmpty, code: CodeUserRequired},
+	{err: ErrUserDoesNotExist, code: CodeUserDoesNotExist},
+	{err: ErrOrganizationDoesNotExist, code: CodeOrganizationDoesNotExist},
+	{err: ErrConflictOfInterest, code: CodeConflictOfInterest},
+}
+
+// codeFor returns code of the sentinel error wrapped by err or fallback code.
+func codeFor(err error, fallback string) string {
+	for _, sentinel := range sentinelCodes {
+		if errors.Is(err, sentinel.err) {
+			return sentinel.code
+		}
+	}
+
+	return fallback
+}
+
+// catalogueEntry returns catalogue entry by code.
+func catalogueEntry(code string) (CatalogueEntry, bool) {
+	for _, entry := range Catalogue {
+		if entry.Code == code {
+			return entry, true
+		}
+	}
+
+	return CatalogueEntry{}, false
+}
+
+// CatalogueHandler sends error catalogue.
+func CatalogueHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(Catalogue); err != nil {
+		slog.Error("failed to send error catalogue", "error", err)
+	}
+}
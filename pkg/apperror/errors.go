@@ -3,6 +3,8 @@ package apperror
 import (
 	"errors"
 	"net/http"
+
+	"github.com/invopop/validation"
 )
 
 var (
@@ -14,12 +16,15 @@ var (
 	ErrForbidden                = errors.New("don't have enough permissions")
 	ErrInternal                 = errors.New("internal error")
 	ErrNotFound                 = errors.New("not found")
+	ErrConflictOfInterest       = errors.New("conflict of interest")
 )
 
 type AppError struct {
 	Code    int
 	Err     error
 	Message string
+	// ErrorCode is the stable code of the error from Catalogue.
+	ErrorCode string
 }
 
 func (h AppError) Unwrap() error {
@@ -31,41 +36,62 @@ func (h AppError) Error() string {
 }
 
 func BadRequest(err error) error {
+	errorCode := CodeInvalidInput
+
+	var validationErrs validation.Errors
+	if errors.As(err, &validationErrs) {
+		errorCode = CodeValidationFailed
+	}
+
 	return &AppError{
-		Code:    http.StatusBadRequest,
-		Message: err.Error(),
-		Err:     err,
+		Code:      http.StatusBadRequest,
+		Message:   err.Error(),
+		Err:       err,
+		ErrorCode: errorCode,
 	}
 }
 
 func InternalServerError(err error) error {
 	return &AppError{
-		Code:    http.StatusInternalServerError,
-		Message: "internal_server_error",
-		Err:     err,
+		Code:      http.StatusInternalServerError,
+		Message:   "internal_server_error",
+		Err:       err,
+		ErrorCode: CodeInternal,
 	}
 }
 
 func Unauthorized(err error) error {
 	return &AppError{
-		Code:    http.StatusUnauthorized,
-		Message: "unauthorized",
-		Err:     err,
+		Code:      http.StatusUnauthorized,
+		Message:   "unauthorized",
+		Err:       err,
+		ErrorCode: codeFor(err, CodeUnauthorized),
 	}
 }
 
 func Forbidden(err error) error {
 	return &AppError{
-		Code:    http.StatusForbidden,
-		Message: "forbidden",
-		Err:     err,
+		Code:      http.StatusForbidden,
+		Message:   "forbidden",
+		Err:       err,
+		ErrorCode: codeFor(err, CodeForbidden),
 	}
 }
 
 func NotFound(err error) error {
 	return &AppError{
-		Code:    http.StatusNotFound,
-		Message: "not_found",
-		Err:     err,
+		Code:      http.StatusNotFound,
+		Message:   "not_found",
+		Err:       err,
+		ErrorCode: CodeNotFound,
+	}
+}
+
+func RequestEntityTooLarge(err error) error {
+	return &AppError{
+		Code:      http.StatusRequestEntityTooLarge,
+		Message:   err.Error(),
+		Err:       err,
+		ErrorCode: CodePayloadTooLarge,
 	}
 }
@@ -0,0 +1,78 @@
+package apperror
+
+import (
+	"errors"
+	"log/slog"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"avito-tenders/pkg/i18n"
+)
+
+// errorDomain is the domain of gRPC ErrorInfo details, reasons of the domain are codes from Catalogue.
+const errorDomain = "avito-tenders"
+
+// grpcCodes maps error codes to gRPC status codes matching HTTP statuses of the codes.
+var grpcCodes = map[string]codes.Code{
+	CodeInvalidInput:             codes.InvalidArgument,
+	CodeValidationFailed:         codes.InvalidArgument,
+	CodeUnauthorized:             codes.Unauthenticated,
+	CodeUserRequired:             codes.Unauthenticated,
+	CodeUserDoesNotExist:         codes.Unauthenticated,
+	CodeOrganizationDoesNotExist: codes.Unauthenticated,
+	CodeForbidden:                codes.PermissionDenied,
+	CodeConflictOfInterest:       codes.PermissionDenied,
+	CodeNotFound:                 codes.NotFound,
+	CodePayloadTooLarge:          codes.ResourceExhausted,
+	CodeInternal:                 codes.Internal,
+}
+
+// GRPCCode returns gRPC status code for the error code, unknown codes are internal errors.
+func GRPCCode(errorCode string) codes.Code {
+	code, ok := grpcCodes[errorCode]
+	if !ok {
+		return codes.Internal
+	}
+
+	return code
+}
+
+// GRPCStatus converts error to gRPC status with messages translated to the given language. The same problem as
+// in HTTP responses is attached as details: its code is the reason of ErrorInfo and violations are listed in
+// BadRequest.
+func GRPCStatus(err error, lang i18n.Lang) *status.Status {
+	var appErr *AppError
+	if !errors.As(err, &appErr) {
+		slog.Error("sending unexpected error", "error", err)
+		appErr = InternalServerError(err).(*AppError)
+	}
+
+	problem := NewProblem(appErr, lang)
+
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: problem.Code, Domain: errorDomain}}
+	if len(problem.Errors) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, violation := range problem.Errors {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Message,
+			})
+		}
+
+		details = append(details, badRequest)
+	}
+
+	st := status.New(GRPCCode(problem.Code), problem.Detail)
+	withDetails, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		slog.Error("failed to attach error details", "error", detailsErr)
+		return st
+	}
+
+	slog.Info("sending error status", "error", err)
+
+	return withDetails
+}
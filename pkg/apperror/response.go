@@ -5,27 +5,142 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/invopop/validation"
+
+	"avito-tenders/pkg/i18n"
 )
 
-type reasonResponse struct {
+const problemContentType = "application/problem+json"
+
+// Problem is the RFC 7807 error response.
+type Problem struct {
+	Type      string      `json:"type"`
+	Title     string      `json:"title"`
+	Status    int         `json:"status"`
+	Detail    string      `json:"detail,omitempty"`
+	Code      string      `json:"code"`
+	RequestID string      `json:"requestId,omitempty"`
+	Errors    []Violation `json:"errors,omitempty"`
+	// Reason is kept for clients of the previous error response format.
 	Reason string `json:"reason"`
 }
 
+// Violation describes invalid field of the request.
+type Violation struct {
+	// Field is the path to the field, nested fields and slice indexes are separated by dots.
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
 func SendError(w http.ResponseWriter, err error) {
 	var appErr *AppError
-	if errors.As(err, &appErr) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(appErr.Code)
-		if err := json.NewEncoder(w).Encode(reasonResponse{Reason: appErr.Message}); err != nil {
-			slog.Error("failed to send reason response", "error", err)
-			http.Error(w, appErr.Message, appErr.Code)
-			return
-		}
+	if !errors.As(err, &appErr) {
+		slog.Error("sending unexpected error", "error", err)
+		appErr = InternalServerError(err).(*AppError)
+	}
 
-		slog.Info("sending error response", "error", err)
+	// Request ID and negotiated language are set to response headers by middlewares, so they are taken from there.
+	lang := i18n.English
+	if contentLanguage := w.Header().Get("Content-Language"); contentLanguage != "" {
+		lang = i18n.Lang(contentLanguage)
+	}
 
+	problem := NewProblem(appErr, lang)
+	problem.RequestID = w.Header().Get(middleware.RequestIDHeader)
+
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(appErr.Code)
+	if err := json.NewEncoder(w).Encode(problem); err != nil {
+		slog.Error("failed to send problem response", "error", err)
 		return
 	}
 
-	http.Error(w, "error occurred", http.StatusInternalServerError)
+	slog.Info("sending error response", "error", err)
+}
+
+// NewProblem converts application error to the problem with messages translated to the given language.
+func NewProblem(appErr *AppError, lang i18n.Lang) Problem {
+	errorCode := appErr.ErrorCode
+	if errorCode == "" {
+		errorCode = CodeInternal
+	}
+
+	problem := Problem{
+		Type:   problemTypePrefix + errorCode,
+		Title:  http.StatusText(appErr.Code),
+		Status: appErr.Code,
+		Detail: i18n.Translate(lang, appErr.Message),
+		Code:   errorCode,
+		Reason: i18n.Translate(lang, appErr.Message),
+	}
+
+	if entry, ok := catalogueEntry(errorCode); ok {
+		problem.Title = i18n.Translate(lang, entry.Title)
+	}
+
+	// Internal errors may contain sensitive details, so only client errors are described.
+	if appErr.Code < http.StatusInternalServerError && appErr.Err != nil {
+		problem.Detail = i18n.Translate(lang, appErr.Err.Error())
+
+		var validationErrs validation.Errors
+		if errors.As(appErr.Err, &validationErrs) {
+			problem.Errors = violations(lang, "", validationErrs)
+			problem.Detail = violationsDetail(problem.Errors)
+		}
+
+		// Message of some errors is the error itself, it is translated the same way.
+		if appErr.Message == appErr.Err.Error() {
+			problem.Reason = problem.Detail
+		}
+	}
+
+	return problem
+}
+
+// violations flattens nested validation errors into list sorted by field.
+func violations(lang i18n.Lang, prefix string, errs validation.Errors) []Violation {
+	result := make([]Violation, 0, len(errs))
+	for field, err := range errs {
+		path := field
+		if prefix != "" {
+			path = strings.Join([]string{prefix, field}, ".")
+		}
+
+		var nested validation.Errors
+		if errors.As(err, &nested) {
+			result = append(result, violations(lang, path, nested)...)
+			continue
+		}
+
+		violation := Violation{Field: path, Code: "validation_invalid", Message: i18n.Translate(lang, err.Error())}
+
+		var validationErr validation.Error
+		if errors.As(err, &validationErr) {
+			violation.Code = validationErr.Code()
+			violation.Message = i18n.TranslateValidation(lang, validationErr)
+		}
+
+		result = append(result, violation)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Field < result[j].Field
+	})
+
+	return result
+}
+
+// violationsDetail joins violations in the same format as validation errors are formatted.
+func violationsDetail(violationList []Violation) string {
+	parts := make([]string, 0, len(violationList))
+	for _, violation := range violationList {
+		parts = append(parts, violation.Field+": "+violation.Message)
+	}
+
+	return strings.Join(parts, "; ") + "."
 }
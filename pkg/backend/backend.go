@@ -6,12 +6,23 @@ import (
 	"github.com/jmoiron/sqlx"
 
 	"avito-tenders/config"
+	"avito-tenders/pkg/blobstorage"
+	"avito-tenders/pkg/health"
+	"avito-tenders/pkg/specvalidator"
 )
 
 // Backend contains application connections to different external services and additional parameters that should be
 // passed to API middlewares.
 type Backend struct {
-	DB *sqlx.DB
+	DB      *sqlx.DB
+	Storage blobstorage.Storage
+	Health  *health.Checker
+
+	AttachmentLimits blobstorage.Limits
+	CORS             CORSOptions
+
+	// SpecViolations receives divergences between API handlers and the OpenAPI spec, nil disables the validation.
+	SpecViolations specvalidator.Reporter
 }
 
 func NewForServer(cfg *config.Config) (Backend, error) {
@@ -21,14 +32,35 @@ func NewForServer(cfg *config.Config) (Backend, error) {
 		Name:                       cfg.PostgresDatabase,
 		Username:                   cfg.PostgresUsername,
 		Password:                   cfg.PostgresPassword,
-		NewConnectionRetryInterval: 1,
-		NewConnectionRetryAttempts: 3,
+		MaxOpenConns:               cfg.PostgresMaxOpenConns,
+		MaxIdleConns:               cfg.PostgresMaxIdleConns,
+		ConnMaxLifetime:            cfg.PostgresConnMaxLifetime,
+		NewConnectionRetryInterval: cfg.PostgresConnectInterval,
+		NewConnectionRetryAttempts: cfg.PostgresConnectAttempts,
 	})
 	if err != nil {
 		return Backend{}, fmt.Errorf("unable to setup DB connection for the new backend: %w", err)
 	}
 
+	storage, err := newStorage(cfg)
+	if err != nil {
+		return Backend{}, fmt.Errorf("unable to setup blob storage for the new backend: %w", err)
+	}
+
+	checker := health.NewChecker()
+	checker.Register("database", health.DBCheck(dbConn.DB))
+
+	var specViolations specvalidator.Reporter
+	if cfg.OpenAPIValidation {
+		specViolations = specvalidator.LogReporter
+	}
+
 	return Backend{
-		DB: dbConn,
+		DB:               dbConn,
+		Storage:          storage,
+		Health:           checker,
+		AttachmentLimits: newAttachmentLimits(cfg),
+		CORS:             newCORSOptions(cfg),
+		SpecViolations:   specViolations,
 	}, nil
 }
@@ -0,0 +1,33 @@
+package backend
+
+import (
+	"avito-tenders/config"
+)
+
+var (
+	defaultCORSAllowedOrigins = []string{"*"}
+	defaultCORSAllowedMethods = []string{"GET"}
+)
+
+// CORSOptions are passed to the CORS middleware of the API router.
+type CORSOptions struct {
+	AllowedOrigins []string
+	AllowedMethods []string
+}
+
+// newCORSOptions returns CORS options from config with defaults for empty values.
+func newCORSOptions(cfg *config.Config) CORSOptions {
+	opts := CORSOptions{
+		AllowedOrigins: cfg.CORSAllowedOrigins,
+		AllowedMethods: cfg.CORSAllowedMethods,
+	}
+
+	if len(opts.AllowedOrigins) == 0 {
+		opts.AllowedOrigins = defaultCORSAllowedOrigins
+	}
+	if len(opts.AllowedMethods) == 0 {
+		opts.AllowedMethods = defaultCORSAllowedMethods
+	}
+
+	return opts
+}
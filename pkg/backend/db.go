@@ -10,6 +10,11 @@ import (
 	"avito-tenders/pkg/postgres"
 )
 
+const (
+	defaultConnectionRetryInterval = time.Second
+	defaultConnectionRetryAttempts = 3
+)
+
 // dbConnectionOpts represents options for a new DB connection.
 type dbConnectionOpts struct {
 	Host                       string
@@ -17,7 +22,10 @@ type dbConnectionOpts struct {
 	Name                       string
 	Username                   string
 	Password                   string
-	NewConnectionRetryInterval int
+	MaxOpenConns               int
+	MaxIdleConns               int
+	ConnMaxLifetime            time.Duration
+	NewConnectionRetryInterval time.Duration
 	NewConnectionRetryAttempts int
 }
 
@@ -29,6 +37,10 @@ func newDBConnection(opts *dbConnectionOpts) (*sqlx.DB, error) {
 		Name:     opts.Name,
 		Username: opts.Username,
 		Password: opts.Password,
+
+		MaxOpenConns:    opts.MaxOpenConns,
+		MaxIdleConns:    opts.MaxIdleConns,
+		ConnMaxLifetime: opts.ConnMaxLifetime,
 	}
 
 	var (
@@ -36,9 +48,17 @@ func newDBConnection(opts *dbConnectionOpts) (*sqlx.DB, error) {
 		err  error
 	)
 
-	newConnectionRetryInterval := time.Second * time.Duration(opts.NewConnectionRetryInterval)
+	newConnectionRetryInterval := opts.NewConnectionRetryInterval
+	if newConnectionRetryInterval <= 0 {
+		newConnectionRetryInterval = defaultConnectionRetryInterval
+	}
+
+	newConnectionRetryAttempts := opts.NewConnectionRetryAttempts
+	if newConnectionRetryAttempts <= 0 {
+		newConnectionRetryAttempts = defaultConnectionRetryAttempts
+	}
 
-	for i := 0; i < opts.NewConnectionRetryAttempts; i++ {
+	for i := 0; i < newConnectionRetryAttempts; i++ {
 		conn, err = postgres.New(connOpts)
 		if err == nil {
 			slog.Info(
@@ -61,7 +81,7 @@ func newDBConnection(opts *dbConnectionOpts) (*sqlx.DB, error) {
 
 	return nil, fmt.Errorf(
 		"unable to open DB connection, after %d retries last error was: %w",
-		opts.NewConnectionRetryAttempts,
+		newConnectionRetryAttempts,
 		err,
 	)
 }
@@ -0,0 +1,75 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"avito-tenders/config"
+	"avito-tenders/pkg/blobstorage"
+)
+
+const (
+	storageDriverLocal = "local"
+	storageDriverS3    = "s3"
+
+	defaultAttachmentMaxSize = 10 << 20
+)
+
+var defaultAttachmentAllowedTypes = []string{"application/pdf", "application/zip", "image/png", "image/jpeg", "text/plain"}
+
+// newStorage wraps blob storage setup.
+func newStorage(cfg *config.Config) (blobstorage.Storage, error) {
+	switch cfg.StorageDriver {
+	case storageDriverS3:
+		storage, err := blobstorage.NewS3(context.Background(), blobstorage.S3Opts{
+			Endpoint:  cfg.StorageS3Endpoint,
+			Bucket:    cfg.StorageS3Bucket,
+			AccessKey: cfg.StorageS3AccessKey,
+			SecretKey: cfg.StorageS3SecretKey,
+			UseSSL:    cfg.StorageS3UseSSL,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		slog.Info("using s3 blob storage", "endpoint", cfg.StorageS3Endpoint, "bucket", cfg.StorageS3Bucket)
+
+		return storage, nil
+	case storageDriverLocal, "":
+		path := cfg.StorageLocalPath
+		if path == "" {
+			path = filepath.Join(os.TempDir(), "avito-tenders-attachments")
+		}
+
+		storage, err := blobstorage.NewLocal(path)
+		if err != nil {
+			return nil, err
+		}
+
+		slog.Info("using local blob storage", "path", path)
+
+		return storage, nil
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.StorageDriver)
+	}
+}
+
+// newAttachmentLimits returns attachment limits from config with defaults for empty values.
+func newAttachmentLimits(cfg *config.Config) blobstorage.Limits {
+	limits := blobstorage.Limits{
+		MaxSize:      cfg.AttachmentMaxSize,
+		AllowedTypes: cfg.AttachmentAllowedTypes,
+	}
+
+	if limits.MaxSize <= 0 {
+		limits.MaxSize = defaultAttachmentMaxSize
+	}
+	if len(limits.AllowedTypes) == 0 {
+		limits.AllowedTypes = defaultAttachmentAllowedTypes
+	}
+
+	return limits
+}
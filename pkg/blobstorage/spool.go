@@ -0,0 +1,103 @@
+package blobstorage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"slices"
+)
+
+var (
+	ErrTooLarge       = errors.New("file is too large")
+	ErrTypeNotAllowed = errors.New("file type is not allowed")
+	ErrEmpty          = errors.New("file is empty")
+)
+
+// Limits restricts blobs accepted by Spool.
+type Limits struct {
+	MaxSize      int64
+	AllowedTypes []string
+}
+
+// Blob is the uploaded content spooled to temporary file with its hash, size and detected MIME type.
+type Blob struct {
+	file        *os.File
+	Size        int64
+	SHA256      string
+	ContentType string
+}
+
+// Spool copies content into temporary file, computing SHA-256 hash and checking limits.
+// Content type is detected from content itself, so client provided type is never trusted.
+func Spool(r io.Reader, limits Limits) (*Blob, error) {
+	file, err := os.CreateTemp("", "blob-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	blob := &Blob{file: file}
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(file, hash), io.LimitReader(r, limits.MaxSize+1))
+	if err != nil {
+		blob.Close()
+		return nil, fmt.Errorf("failed to spool content: %w", err)
+	}
+
+	if size == 0 {
+		blob.Close()
+		return nil, ErrEmpty
+	}
+	if size > limits.MaxSize {
+		blob.Close()
+		return nil, ErrTooLarge
+	}
+
+	head := make([]byte, 512)
+	n, err := file.ReadAt(head, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		blob.Close()
+		return nil, fmt.Errorf("failed to read content: %w", err)
+	}
+
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(head[:n]))
+	if err != nil || !slices.Contains(limits.AllowedTypes, contentType) {
+		blob.Close()
+		return nil, ErrTypeNotAllowed
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		blob.Close()
+		return nil, fmt.Errorf("failed to rewind content: %w", err)
+	}
+
+	blob.Size = size
+	blob.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	blob.ContentType = contentType
+
+	return blob, nil
+}
+
+// Key returns content addressed storage key of the blob.
+func (b *Blob) Key() string {
+	return fmt.Sprintf("sha256/%s/%s", b.SHA256[:2], b.SHA256)
+}
+
+func (b *Blob) Read(p []byte) (int, error) {
+	return b.file.Read(p)
+}
+
+// Close removes temporary file.
+func (b *Blob) Close() error {
+	err := b.file.Close()
+	if removeErr := os.Remove(b.file.Name()); removeErr != nil && !errors.Is(removeErr, os.ErrNotExist) {
+		return removeErr
+	}
+
+	return err
+}
@@ -0,0 +1,19 @@
+// Package blobstorage implements storage for binary objects such as attachments.
+package blobstorage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// Storage is the interface of blob storage. Blobs are immutable and addressed by key.
+type Storage interface {
+	// Put saves blob of the given size under the key. Saving blob under existing key overwrites it.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+
+	// Get returns reader of the blob. Reader must be closed by caller.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+}
@@ -0,0 +1,290 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"avito-tenders/internal/api/bids/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/queryparams"
+)
+
+// CreateBid creates bid on behalf of request.AuthorID.
+func (c *Client) CreateBid(ctx context.Context, request dtos.CreateBidRequest) (dtos.BidResponse, error) {
+	var bid dtos.BidResponse
+	err := c.do(ctx, http.MethodPost, "/bids/new", nil, request, &bid)
+
+	return bid, err
+}
+
+// MyBids returns page of the user's bids.
+func (c *Client) MyBids(ctx context.Context, username string, pagination queryparams.Pagination) ([]dtos.BidResponse, error) {
+	var bids []dtos.BidResponse
+	err := c.do(ctx, http.MethodGet, "/bids/my", withPagination(userQuery(username), pagination), nil, &bids)
+
+	return bids, err
+}
+
+// MyBidsIterator iterates over all bids of the user.
+func (c *Client) MyBidsIterator(username string) *Iterator[dtos.BidResponse] {
+	return newIterator(c.pageSize, func(ctx context.Context, pagination queryparams.Pagination) ([]dtos.BidResponse, error) {
+		return c.MyBids(ctx, username, pagination)
+	})
+}
+
+// TenderBids returns page of the tender bids visible to the user.
+func (c *Client) TenderBids(ctx context.Context, tenderID, username string, pagination queryparams.Pagination) ([]dtos.BidResponse, error) {
+	var bids []dtos.BidResponse
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/bids/%s/list", escape(tenderID)), withPagination(userQuery(username), pagination), nil, &bids)
+
+	return bids, err
+}
+
+// TenderBidsIterator iterates over all tender bids visible to the user.
+func (c *Client) TenderBidsIterator(tenderID, username string) *Iterator[dtos.BidResponse] {
+	return newIterator(c.pageSize, func(ctx context.Context, pagination queryparams.Pagination) ([]dtos.BidResponse, error) {
+		return c.TenderBids(ctx, tenderID, username, pagination)
+	})
+}
+
+// BidStatus returns bid status.
+func (c *Client) BidStatus(ctx context.Context, bidID, username string) (entity.BidStatus, error) {
+	var status string
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/bids/%s/status", escape(bidID)), userQuery(username), nil, &status)
+
+	return entity.BidStatus(status), err
+}
+
+// UpdateBidStatus changes bid status.
+func (c *Client) UpdateBidStatus(ctx context.Context, bidID string, status entity.BidStatus, username string) (dtos.BidResponse, error) {
+	query := userQuery(username)
+	query.Set("status", string(status))
+
+	var bid dtos.BidResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/bids/%s/status", escape(bidID)), query, nil, &bid)
+
+	return bid, err
+}
+
+// EditBid changes non-empty fields of the bid.
+func (c *Client) EditBid(ctx context.Context, bidID, username string, body dtos.EditBidBody) (dtos.BidResponse, error) {
+	var bid dtos.BidResponse
+	err := c.do(ctx, http.MethodPatch, fmt.Sprintf("/bids/%s/edit", escape(bidID)), userQuery(username), body, &bid)
+
+	return bid, err
+}
+
+// RollbackBid restores bid version as the new one.
+func (c *Client) RollbackBid(ctx context.Context, bidID string, version int, username string) (dtos.BidResponse, error) {
+	var bid dtos.BidResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/bids/%s/rollback/%d", escape(bidID), version), userQuery(username), nil, &bid)
+
+	return bid, err
+}
+
+// SubmitDecision submits decision of the tender organization employee.
+func (c *Client) SubmitDecision(ctx context.Context, bidID string, decision entity.BidDecision, username string) (dtos.BidResponse, error) {
+	query := userQuery(username)
+	query.Set("decision", string(decision))
+
+	var bid dtos.BidResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/bids/%s/submit_decision", escape(bidID)), query, nil, &bid)
+
+	return bid, err
+}
+
+// SubmitCommentedDecision submits decision of the tender organization employee with the comment.
+func (c *Client) SubmitCommentedDecision(ctx context.Context, bidID string, decision entity.BidDecision, comment, username string) (dtos.BidResponse, error) {
+	query := userQuery(username)
+	query.Set("decision", string(decision))
+	query.Set("comment", comment)
+
+	var bid dtos.BidResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/bids/%s/submit_decision", escape(bidID)), query, nil, &bid)
+
+	return bid, err
+}
+
+// RetractDecision removes the vote of the tender organization employee until the bid is decided.
+func (c *Client) RetractDecision(ctx context.Context, bidID, username string) (dtos.BidResponse, error) {
+	var bid dtos.BidResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/bids/%s/retract_decision", escape(bidID)), userQuery(username), nil, &bid)
+
+	return bid, err
+}
+
+// VoteTally returns votes on the bid.
+func (c *Client) VoteTally(ctx context.Context, bidID, username string) (dtos.VoteTallyResponse, error) {
+	var tally dtos.VoteTallyResponse
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/bids/%s/votes", escape(bidID)), userQuery(username), nil, &tally)
+
+	return tally, err
+}
+
+// DeclineAward withdraws the award on behalf of the bid author, the reason is optional.
+func (c *Client) DeclineAward(ctx context.Context, bidID, reason, username string) (dtos.AwardResponse, error) {
+	query := userQuery(username)
+	if reason != "" {
+		query.Set("reason", reason)
+	}
+
+	var award dtos.AwardResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/bids/%s/decline_award", escape(bidID)), query, nil, &award)
+
+	return award, err
+}
+
+// CancelAward withdraws the award on behalf of the tender organization.
+func (c *Client) CancelAward(ctx context.Context, bidID, reason, username string) (dtos.AwardResponse, error) {
+	query := userQuery(username)
+	query.Set("reason", reason)
+
+	var award dtos.AwardResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/bids/%s/cancel_award", escape(bidID)), query, nil, &award)
+
+	return award, err
+}
+
+// AcceptAward accepts the award offered to the runner-up bid.
+func (c *Client) AcceptAward(ctx context.Context, bidID, username string) (dtos.AwardResponse, error) {
+	var award dtos.AwardResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/bids/%s/accept_award", escape(bidID)), userQuery(username), nil, &award)
+
+	return award, err
+}
+
+// SendFeedback leaves review on the bid.
+func (c *Client) SendFeedback(ctx context.Context, bidID, feedback, username string) (dtos.BidResponse, error) {
+	query := userQuery(username)
+	query.Set("bidFeedback", feedback)
+
+	var bid dtos.BidResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/bids/%s/feedback", escape(bidID)), query, nil, &bid)
+
+	return bid, err
+}
+
+// SendRatedFeedback leaves review on the bid with marks of its supplier.
+func (c *Client) SendRatedFeedback(ctx context.Context, bidID, feedback, username string, ratings dtos.FeedbackRatings) (dtos.BidResponse, error) {
+	query := userQuery(username)
+	query.Set("bidFeedback", feedback)
+	for name, rating := range map[string]*int{
+		"rating":              ratings.Rating,
+		"qualityRating":       ratings.Quality,
+		"timelinessRating":    ratings.Timeliness,
+		"communicationRating": ratings.Communication,
+	} {
+		if rating != nil {
+			query.Set(name, strconv.Itoa(*rating))
+		}
+	}
+
+	var bid dtos.BidResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/bids/%s/feedback", escape(bidID)), query, nil, &bid)
+
+	return bid, err
+}
+
+// Reviews returns page of reviews on bids of the author, requested by the tender organization employee.
+func (c *Client) Reviews(ctx context.Context, tenderID, authorUsername, requesterUsername string, pagination queryparams.Pagination) ([]dtos.ReviewResponse, error) {
+	query := url.Values{
+		"authorUsername":    {authorUsername},
+		"requesterUsername": {requesterUsername},
+	}
+
+	var reviews []dtos.ReviewResponse
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/bids/%s/reviews", escape(tenderID)), withPagination(query, pagination), nil, &reviews)
+
+	return reviews, err
+}
+
+// ReviewsIterator iterates over all reviews on bids of the author.
+func (c *Client) ReviewsIterator(tenderID, authorUsername, requesterUsername string) *Iterator[dtos.ReviewResponse] {
+	return newIterator(c.pageSize, func(ctx context.Context, pagination queryparams.Pagination) ([]dtos.ReviewResponse, error) {
+		return c.Reviews(ctx, tenderID, authorUsername, requesterUsername, pagination)
+	})
+}
+
+// ReviewsSummary returns reputation of the bids author, requested by the tender organization employee.
+func (c *Client) ReviewsSummary(ctx context.Context, tenderID, authorUsername, requesterUsername string) (dtos.ReviewsSummaryResponse, error) {
+	query := url.Values{
+		"authorUsername":    {authorUsername},
+		"requesterUsername": {requesterUsername},
+	}
+
+	var summary dtos.ReviewsSummaryResponse
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/bids/%s/reviews/summary", escape(tenderID)), query, nil, &summary)
+
+	return summary, err
+}
+
+// ReplyToReview answers the review on behalf of the bid author.
+func (c *Client) ReplyToReview(ctx context.Context, reviewID, reply, username string) (dtos.ReviewResponse, error) {
+	query := userQuery(username)
+	query.Set("reply", reply)
+
+	var review dtos.ReviewResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/bids/reviews/%s/reply", escape(reviewID)), query, nil, &review)
+
+	return review, err
+}
+
+// DisputeReview hides the review until the tender organization arbitrates the dispute.
+func (c *Client) DisputeReview(ctx context.Context, reviewID, reason, username string) (dtos.ReviewResponse, error) {
+	query := userQuery(username)
+	query.Set("reason", reason)
+
+	var review dtos.ReviewResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/bids/reviews/%s/dispute", escape(reviewID)), query, nil, &review)
+
+	return review, err
+}
+
+// ArbitrateReview accepts or rejects the dispute of the review, the comment is optional.
+func (c *Client) ArbitrateReview(ctx context.Context, reviewID string, decision entity.DisputeStatus, comment, username string) (dtos.ReviewResponse, error) {
+	query := userQuery(username)
+	query.Set("decision", string(decision))
+	if comment != "" {
+		query.Set("comment", comment)
+	}
+
+	var review dtos.ReviewResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/bids/reviews/%s/arbitrate", escape(reviewID)), query, nil, &review)
+
+	return review, err
+}
+
+// ReviewHistory returns audit log of the review.
+func (c *Client) ReviewHistory(ctx context.Context, reviewID, username string) ([]dtos.ReviewAuditResponse, error) {
+	var history []dtos.ReviewAuditResponse
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/bids/reviews/%s/history", escape(reviewID)), userQuery(username), nil, &history)
+
+	return history, err
+}
+
+// BidItems returns bid quotes for tender line items.
+func (c *Client) BidItems(ctx context.Context, bidID, username string) (dtos.BidItemsResponse, error) {
+	var items dtos.BidItemsResponse
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/bids/%s/items", escape(bidID)), userQuery(username), nil, &items)
+
+	return items, err
+}
+
+// SetBidItems replaces bid quotes.
+func (c *Client) SetBidItems(ctx context.Context, bidID, username string, quotes []dtos.BidItemQuote) (dtos.BidItemsResponse, error) {
+	var items dtos.BidItemsResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/bids/%s/items", escape(bidID)), userQuery(username), quotes, &items)
+
+	return items, err
+}
+
+// CompareBidItems returns quotes of the page of tender bids item by item.
+func (c *Client) CompareBidItems(ctx context.Context, tenderID, username string, pagination queryparams.Pagination) (dtos.ItemsComparisonResponse, error) {
+	var comparison dtos.ItemsComparisonResponse
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/bids/%s/comparison", escape(tenderID)), withPagination(userQuery(username), pagination), nil, &comparison)
+
+	return comparison, err
+}
@@ -0,0 +1,135 @@
+// Package client implements typed Go client of the tenders API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/queryparams"
+)
+
+const apiPrefix = "/api"
+
+// Client calls tenders API. It is safe for concurrent use.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	language   string
+	pageSize   int
+}
+
+// New creates client for the service at baseURL, e.g. "http://localhost:8080".
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: http.DefaultClient,
+		pageSize:   maxPageSize,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// do sends JSON request and decodes JSON response into out. Error responses are returned as *Error.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	reqURL := c.baseURL + apiPrefix + path
+	if len(query) != 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.language != "" {
+		req.Header.Set("Accept-Language", c.language)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return newError(res)
+	}
+
+	// Some endpoints respond with status only.
+	if out == nil {
+		return nil
+	}
+
+	// Status endpoints respond with plain status value.
+	if s, ok := out.(*string); ok {
+		data, err := io.ReadAll(res.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read response: %w", err)
+		}
+		*s = string(data)
+
+		return nil
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+func newError(res *http.Response) error {
+	e := &Error{StatusCode: res.StatusCode}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil || json.Unmarshal(data, &e.Problem) != nil {
+		// Response is not a problem, e.g. it is returned by proxy.
+		e.Problem = apperror.Problem{Status: res.StatusCode, Title: http.StatusText(res.StatusCode)}
+	}
+
+	return e
+}
+
+// userQuery returns query with username, it is required by most of the routes.
+func userQuery(username string) url.Values {
+	return url.Values{"username": {username}}
+}
+
+func withPagination(query url.Values, pagination queryparams.Pagination) url.Values {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("limit", strconv.Itoa(pagination.Limit))
+	query.Set("offset", strconv.Itoa(pagination.Offset))
+
+	return query
+}
+
+// escape escapes path parameter.
+func escape(param string) string {
+	return url.PathEscape(param)
+}
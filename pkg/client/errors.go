@@ -0,0 +1,47 @@
+package client
+
+import (
+	"fmt"
+
+	"avito-tenders/pkg/apperror"
+)
+
+// Error is the RFC 7807 problem returned by API.
+type Error struct {
+	StatusCode int
+	apperror.Problem
+}
+
+func (e *Error) Error() string {
+	if e.Detail != "" {
+		return fmt.Sprintf("%d %s: %s", e.StatusCode, e.Title, e.Detail)
+	}
+
+	return fmt.Sprintf("%d %s", e.StatusCode, e.Title)
+}
+
+// Is reports whether target is the sentinel error with the same code, so errors.Is(err, client.ErrNotFound) works.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+
+	return ok && t.StatusCode == 0 && t.Code != "" && t.Code == e.Code
+}
+
+func sentinel(code string) *Error {
+	return &Error{Problem: apperror.Problem{Code: code}}
+}
+
+// Sentinel errors for the codes of apperror catalogue.
+var (
+	ErrInvalidInput             = sentinel(apperror.CodeInvalidInput)
+	ErrValidationFailed         = sentinel(apperror.CodeValidationFailed)
+	ErrUnauthorized             = sentinel(apperror.CodeUnauthorized)
+	ErrUserRequired             = sentinel(apperror.CodeUserRequired)
+	ErrUserDoesNotExist         = sentinel(apperror.CodeUserDoesNotExist)
+	ErrOrganizationDoesNotExist = sentinel(apperror.CodeOrganizationDoesNotExist)
+	ErrForbidden                = sentinel(apperror.CodeForbidden)
+	ErrConflictOfInterest       = sentinel(apperror.CodeConflictOfInterest)
+	ErrNotFound                 = sentinel(apperror.CodeNotFound)
+	ErrPayloadTooLarge          = sentinel(apperror.CodePayloadTooLarge)
+	ErrInternal                 = sentinel(apperror.CodeInternal)
+)
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+
+	"avito-tenders/pkg/queryparams"
+)
+
+// maxPageSize is the maximum limit accepted by API.
+const maxPageSize = 50
+
+// Iterator walks over all pages of the list endpoint:
+//
+//	it := c.TendersIterator(nil)
+//	for it.Next(ctx) {
+//		tender := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type Iterator[T any] struct {
+	fetch    func(ctx context.Context, pagination queryparams.Pagination) ([]T, error)
+	pageSize int
+	page     []T
+	index    int
+	offset   int
+	done     bool
+	err      error
+}
+
+func newIterator[T any](pageSize int, fetch func(ctx context.Context, pagination queryparams.Pagination) ([]T, error)) *Iterator[T] {
+	return &Iterator[T]{fetch: fetch, pageSize: pageSize, index: -1}
+}
+
+// Next advances to the next value, fetching the next page when needed. It returns false when values are over or
+// request failed.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.index++
+	if it.index < len(it.page) {
+		return true
+	}
+	if it.done {
+		return false
+	}
+
+	page, err := it.fetch(ctx, queryparams.Pagination{Limit: it.pageSize, Offset: it.offset})
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.page, it.index = page, 0
+	it.offset += len(page)
+	it.done = len(page) < it.pageSize
+
+	return len(page) > 0
+}
+
+// Value returns the current value.
+func (it *Iterator[T]) Value() T {
+	return it.page[it.index]
+}
+
+// Err returns error of the failed request.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All collects remaining values.
+func (it *Iterator[T]) All(ctx context.Context) ([]T, error) {
+	var values []T
+	for it.Next(ctx) {
+		values = append(values, it.Value())
+	}
+
+	return values, it.Err()
+}
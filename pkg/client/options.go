@@ -0,0 +1,29 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Option -.
+type Option func(*Client)
+
+// HTTPClient sets HTTP client used to send requests, http.DefaultClient is used by default.
+func HTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// Language sets Accept-Language header, so error messages are localized.
+func Language(language string) Option {
+	return func(c *Client) {
+		c.language = language
+	}
+}
+
+// PageSize sets number of values fetched by one request of iterators, it is limited by API maximum.
+func PageSize(size int) Option {
+	return func(c *Client) {
+		c.pageSize = max(1, min(size, maxPageSize))
+	}
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"avito-tenders/internal/api/organization/dtos"
+	"avito-tenders/pkg/queryparams"
+)
+
+// RelatedParties returns parties declared as related by the organization.
+func (c *Client) RelatedParties(ctx context.Context, organizationID, username string) ([]dtos.RelatedPartyResponse, error) {
+	var parties []dtos.RelatedPartyResponse
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/organizations/%s/related_parties", escape(organizationID)), userQuery(username), nil, &parties)
+
+	return parties, err
+}
+
+// AddRelatedParty declares the user or the organization related to the organization, so it can't bid on tenders
+// of the organization.
+func (c *Client) AddRelatedParty(ctx context.Context, organizationID, username string, body dtos.RelatedPartyBody) (dtos.RelatedPartyResponse, error) {
+	var party dtos.RelatedPartyResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/organizations/%s/related_parties", escape(organizationID)), userQuery(username), body, &party)
+
+	return party, err
+}
+
+// RemoveRelatedParty removes declaration of the related party.
+func (c *Client) RemoveRelatedParty(ctx context.Context, organizationID string, party dtos.RelatedPartyBody, username string) error {
+	path := fmt.Sprintf("/organizations/%s/related_parties/%s/%s", escape(organizationID), escape(string(party.PartyType)), escape(party.PartyID))
+
+	return c.do(ctx, http.MethodDelete, path, userQuery(username), nil, nil)
+}
+
+// ConflictAttempts returns bids refused on tenders of the organization because of conflict of interest.
+func (c *Client) ConflictAttempts(ctx context.Context, organizationID, username string, pagination queryparams.Pagination) ([]dtos.ConflictAttemptResponse, error) {
+	var attempts []dtos.ConflictAttemptResponse
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/organizations/%s/conflict_attempts", escape(organizationID)), withPagination(userQuery(username), pagination), nil, &attempts)
+
+	return attempts, err
+}
@@ -0,0 +1,17 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"avito-tenders/internal/api/suppliers/dtos"
+)
+
+// SupplierReputation returns reputation of the user or the organization as a bid supplier.
+func (c *Client) SupplierReputation(ctx context.Context, supplierID, username string) (dtos.ReputationResponse, error) {
+	var reputation dtos.ReputationResponse
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/suppliers/%s/reputation", escape(supplierID)), userQuery(username), nil, &reputation)
+
+	return reputation, err
+}
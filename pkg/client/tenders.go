@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/queryparams"
+)
+
+// Tenders returns page of published tenders, optionally filtered by service types.
+func (c *Client) Tenders(ctx context.Context, serviceTypes []entity.ServiceType, pagination queryparams.Pagination) ([]dtos.TenderResponse, error) {
+	query := url.Values{}
+	for _, serviceType := range serviceTypes {
+		query.Add("service_type", string(serviceType))
+	}
+
+	var tenders []dtos.TenderResponse
+	err := c.do(ctx, http.MethodGet, "/tenders/", withPagination(query, pagination), nil, &tenders)
+
+	return tenders, err
+}
+
+// TendersIterator iterates over all published tenders.
+func (c *Client) TendersIterator(serviceTypes []entity.ServiceType) *Iterator[dtos.TenderResponse] {
+	return newIterator(c.pageSize, func(ctx context.Context, pagination queryparams.Pagination) ([]dtos.TenderResponse, error) {
+		return c.Tenders(ctx, serviceTypes, pagination)
+	})
+}
+
+// CreateTender creates tender on behalf of request.CreatorUsername.
+func (c *Client) CreateTender(ctx context.Context, request dtos.CreateTenderRequest) (dtos.TenderResponse, error) {
+	var tender dtos.TenderResponse
+	err := c.do(ctx, http.MethodPost, "/tenders/new", nil, request, &tender)
+
+	return tender, err
+}
+
+// MyTenders returns page of tenders of the user's organization.
+func (c *Client) MyTenders(ctx context.Context, username string, pagination queryparams.Pagination) ([]dtos.TenderResponse, error) {
+	var tenders []dtos.TenderResponse
+	err := c.do(ctx, http.MethodGet, "/tenders/my", withPagination(userQuery(username), pagination), nil, &tenders)
+
+	return tenders, err
+}
+
+// MyTendersIterator iterates over all tenders of the user's organization.
+func (c *Client) MyTendersIterator(username string) *Iterator[dtos.TenderResponse] {
+	return newIterator(c.pageSize, func(ctx context.Context, pagination queryparams.Pagination) ([]dtos.TenderResponse, error) {
+		return c.MyTenders(ctx, username, pagination)
+	})
+}
+
+// TenderStatus returns tender status, username is required for tenders that are not published.
+func (c *Client) TenderStatus(ctx context.Context, tenderID, username string) (entity.TenderStatus, error) {
+	var query url.Values
+	if username != "" {
+		query = userQuery(username)
+	}
+
+	var status string
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/tenders/%s/status", escape(tenderID)), query, nil, &status)
+
+	return entity.TenderStatus(status), err
+}
+
+// UpdateTenderStatus changes tender status.
+func (c *Client) UpdateTenderStatus(ctx context.Context, tenderID string, status entity.TenderStatus, username string) (dtos.TenderResponse, error) {
+	query := userQuery(username)
+	query.Set("status", string(status))
+
+	var tender dtos.TenderResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/tenders/%s/status", escape(tenderID)), query, nil, &tender)
+
+	return tender, err
+}
+
+// EditTender changes non-empty fields of the tender.
+func (c *Client) EditTender(ctx context.Context, tenderID, username string, edit dtos.EditTender) (dtos.TenderResponse, error) {
+	var tender dtos.TenderResponse
+	err := c.do(ctx, http.MethodPatch, fmt.Sprintf("/tenders/%s/edit", escape(tenderID)), userQuery(username), edit, &tender)
+
+	return tender, err
+}
+
+// RollbackTender restores tender version as the new one.
+func (c *Client) RollbackTender(ctx context.Context, tenderID string, version int, username string) (dtos.TenderResponse, error) {
+	var tender dtos.TenderResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/tenders/%s/rollback/%d", escape(tenderID), version), userQuery(username), nil, &tender)
+
+	return tender, err
+}
+
+// Lots returns lots of the tender.
+func (c *Client) Lots(ctx context.Context, tenderID, username string) ([]dtos.LotResponse, error) {
+	var lots []dtos.LotResponse
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/tenders/%s/lots", escape(tenderID)), userQuery(username), nil, &lots)
+
+	return lots, err
+}
+
+// CreateLot adds lot to the tender.
+func (c *Client) CreateLot(ctx context.Context, tenderID, username string, body dtos.CreateLotBody) (dtos.LotResponse, error) {
+	var lot dtos.LotResponse
+	err := c.do(ctx, http.MethodPost, fmt.Sprintf("/tenders/%s/lots/new", escape(tenderID)), userQuery(username), body, &lot)
+
+	return lot, err
+}
+
+// EditLot changes non-empty fields of the lot.
+func (c *Client) EditLot(ctx context.Context, tenderID, lotID, username string, body dtos.EditLotBody) (dtos.LotResponse, error) {
+	var lot dtos.LotResponse
+	err := c.do(ctx, http.MethodPatch, fmt.Sprintf("/tenders/%s/lots/%s/edit", escape(tenderID), escape(lotID)), userQuery(username), body, &lot)
+
+	return lot, err
+}
+
+// CancelLot cancels the lot.
+func (c *Client) CancelLot(ctx context.Context, tenderID, lotID, username string) (dtos.LotResponse, error) {
+	var lot dtos.LotResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/tenders/%s/lots/%s/cancel", escape(tenderID), escape(lotID)), userQuery(username), nil, &lot)
+
+	return lot, err
+}
+
+// RollbackLot restores lot version as the new one.
+func (c *Client) RollbackLot(ctx context.Context, tenderID, lotID string, version int, username string) (dtos.LotResponse, error) {
+	var lot dtos.LotResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/tenders/%s/lots/%s/rollback/%d", escape(tenderID), escape(lotID), version), userQuery(username), nil, &lot)
+
+	return lot, err
+}
+
+// Items returns line items of the tender.
+func (c *Client) Items(ctx context.Context, tenderID, username string) ([]dtos.ItemResponse, error) {
+	var items []dtos.ItemResponse
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/tenders/%s/items", escape(tenderID)), userQuery(username), nil, &items)
+
+	return items, err
+}
+
+// CreateItem adds line item to the tender.
+func (c *Client) CreateItem(ctx context.Context, tenderID, username string, body dtos.CreateItemBody) (dtos.ItemResponse, error) {
+	var item dtos.ItemResponse
+	err := c.do(ctx, http.MethodPost, fmt.Sprintf("/tenders/%s/items/new", escape(tenderID)), userQuery(username), body, &item)
+
+	return item, err
+}
+
+// EditItem changes non-empty fields of the line item.
+func (c *Client) EditItem(ctx context.Context, tenderID, itemID, username string, body dtos.EditItemBody) (dtos.ItemResponse, error) {
+	var item dtos.ItemResponse
+	err := c.do(ctx, http.MethodPatch, fmt.Sprintf("/tenders/%s/items/%s/edit", escape(tenderID), escape(itemID)), userQuery(username), body, &item)
+
+	return item, err
+}
+
+// AwardSettings returns award settings of the tender.
+func (c *Client) AwardSettings(ctx context.Context, tenderID, username string) (dtos.AwardSettingsResponse, error) {
+	var settings dtos.AwardSettingsResponse
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/tenders/%s/award_settings", escape(tenderID)), userQuery(username), nil, &settings)
+
+	return settings, err
+}
+
+// SetAwardSettings replaces award settings of the tender.
+func (c *Client) SetAwardSettings(ctx context.Context, tenderID, username string, body dtos.AwardSettingsBody) (dtos.AwardSettingsResponse, error) {
+	var settings dtos.AwardSettingsResponse
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("/tenders/%s/award_settings", escape(tenderID)), userQuery(username), body, &settings)
+
+	return settings, err
+}
@@ -0,0 +1,156 @@
+// Package dataloader batches loads of values by keys requested concurrently, e.g. by GraphQL resolvers of list
+// items, into a single fetch.
+//
+// Loader is meant to be request-scoped: loaded values are cached for its lifetime and are not invalidated.
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	_defaultWait     = 2 * time.Millisecond
+	_defaultMaxBatch = 100
+)
+
+// FetchFunc fetches values of the keys, keys missing from the result are reported as not found.
+type FetchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// Loader collects keys requested within the wait window and fetches them at once.
+type Loader[K comparable, V any] struct {
+	fetch    FetchFunc[K, V]
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	results map[K]*result[V]
+	batch   *batch[K, V]
+}
+
+type result[V any] struct {
+	done  chan struct{}
+	value V
+	found bool
+	err   error
+}
+
+type batch[K comparable, V any] struct {
+	keys    []K
+	results []*result[V]
+	timer   *time.Timer
+}
+
+// New creates loader of values fetched by fetch.
+func New[K comparable, V any](fetch FetchFunc[K, V], opts ...Option) *Loader[K, V] {
+	o := options{
+		wait:     _defaultWait,
+		maxBatch: _defaultMaxBatch,
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &Loader[K, V]{
+		fetch:    fetch,
+		wait:     o.wait,
+		maxBatch: o.maxBatch,
+		results:  make(map[K]*result[V]),
+	}
+}
+
+// Load returns value of the key and whether it was found. The batch is fetched with the context of its first load.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, bool, error) {
+	l.mu.Lock()
+	res, ok := l.results[key]
+	if !ok {
+		res = &result[V]{done: make(chan struct{})}
+		l.results[key] = res
+		l.enqueue(ctx, key, res)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-res.done:
+		return res.value, res.found, res.err
+	case <-ctx.Done():
+		var zero V
+		return zero, false, ctx.Err()
+	}
+}
+
+// LoadMany returns found values of the keys, missing keys are skipped.
+func (l *Loader[K, V]) LoadMany(ctx context.Context, keys []K) ([]V, error) {
+	values := make([]V, 0, len(keys))
+	for _, key := range keys {
+		value, found, err := l.Load(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			values = append(values, value)
+		}
+	}
+
+	return values, nil
+}
+
+// Prime caches the value of the key, e.g. one fetched along with its parent, unless the key is already loaded.
+func (l *Loader[K, V]) Prime(key K, value V) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.results[key]; ok {
+		return
+	}
+
+	res := &result[V]{done: make(chan struct{}), value: value, found: true}
+	close(res.done)
+	l.results[key] = res
+}
+
+// enqueue adds key to the pending batch, it must be called with the mutex held.
+func (l *Loader[K, V]) enqueue(ctx context.Context, key K, res *result[V]) {
+	if l.batch == nil {
+		b := &batch[K, V]{}
+		b.timer = time.AfterFunc(l.wait, func() {
+			l.mu.Lock()
+			if l.batch != b {
+				// Batch is already dispatched as full.
+				l.mu.Unlock()
+				return
+			}
+			l.batch = nil
+			l.mu.Unlock()
+
+			l.dispatch(ctx, b)
+		})
+		l.batch = b
+	}
+
+	b := l.batch
+	b.keys = append(b.keys, key)
+	b.results = append(b.results, res)
+
+	if len(b.keys) >= l.maxBatch {
+		b.timer.Stop()
+		l.batch = nil
+		go l.dispatch(ctx, b)
+	}
+}
+
+func (l *Loader[K, V]) dispatch(ctx context.Context, b *batch[K, V]) {
+	values, err := l.fetch(ctx, b.keys)
+	for i, key := range b.keys {
+		res := b.results[i]
+		if err != nil {
+			res.err = err
+		} else {
+			res.value, res.found = values[key]
+		}
+		close(res.done)
+	}
+}
@@ -0,0 +1,27 @@
+package dataloader
+
+import (
+	"time"
+)
+
+type options struct {
+	wait     time.Duration
+	maxBatch int
+}
+
+// Option -.
+type Option func(*options)
+
+// Wait is how long the loader collects keys before fetching them.
+func Wait(wait time.Duration) Option {
+	return func(o *options) {
+		o.wait = wait
+	}
+}
+
+// MaxBatch is the number of keys that are fetched immediately without waiting for more.
+func MaxBatch(size int) Option {
+	return func(o *options) {
+		o.maxBatch = size
+	}
+}
@@ -2,7 +2,9 @@ package fwcontext
 
 import (
 	"context"
+	"log/slog"
 
+	"avito-tenders/pkg/i18n"
 	"avito-tenders/pkg/queryparams"
 )
 
@@ -11,6 +13,8 @@ type CtxKey int
 const (
 	UsernameCtxKey CtxKey = iota
 	PaginationCtxKey
+	LanguageCtxKey
+	LoggerCtxKey
 )
 
 func GetUsername(ctx context.Context) string {
@@ -33,3 +37,27 @@ func GetPagination(ctx context.Context) queryparams.Pagination {
 
 	return pagination
 }
+
+func GetLanguage(ctx context.Context) i18n.Lang {
+	lang, ok := ctx.Value(LanguageCtxKey).(i18n.Lang)
+	if !ok {
+		lang = i18n.English
+	}
+
+	return lang
+}
+
+// WithLogger returns context with request-scoped logger.
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, LoggerCtxKey, logger)
+}
+
+// GetLogger returns request-scoped logger or default logger if there is no request scope.
+func GetLogger(ctx context.Context) *slog.Logger {
+	logger, ok := ctx.Value(LoggerCtxKey).(*slog.Logger)
+	if !ok {
+		logger = slog.Default()
+	}
+
+	return logger
+}
@@ -0,0 +1,22 @@
+package grpcserver
+
+import (
+	"time"
+)
+
+// Option -.
+type Option func(*Server)
+
+// Address -.
+func Address(address string) Option {
+	return func(s *Server) {
+		s.address = address
+	}
+}
+
+// ShutdownTimeout -.
+func ShutdownTimeout(timeout time.Duration) Option {
+	return func(s *Server) {
+		s.shutdownTimeout = timeout
+	}
+}
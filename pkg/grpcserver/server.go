@@ -0,0 +1,83 @@
+// Package grpcserver implements gRPC server with the same lifecycle as httpserver.
+package grpcserver
+
+import (
+	"net"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	_defaultAddr            = ":50051"
+	_defaultShutdownTimeout = 3 * time.Second
+)
+
+// Server -.
+type Server struct {
+	server          *grpc.Server
+	address         string
+	notify          chan error
+	shutdownTimeout time.Duration
+	running         atomic.Bool
+}
+
+// New starts serving the gRPC server.
+func New(server *grpc.Server, opts ...Option) *Server {
+	s := &Server{
+		server:          server,
+		address:         _defaultAddr,
+		notify:          make(chan error, 1),
+		shutdownTimeout: _defaultShutdownTimeout,
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.start()
+
+	return s
+}
+
+func (s *Server) start() {
+	s.running.Store(true)
+
+	go func() {
+		listener, err := net.Listen("tcp", s.address)
+		if err == nil {
+			err = s.server.Serve(listener)
+		}
+
+		s.running.Store(false)
+		s.notify <- err
+		close(s.notify)
+	}()
+}
+
+// Notify -.
+func (s *Server) Notify() <-chan error {
+	return s.notify
+}
+
+// Running reports whether the server still accepts connections.
+func (s *Server) Running() bool {
+	return s.running.Load()
+}
+
+// Shutdown gracefully stops the server, requests that are not finished in shutdown timeout are canceled.
+func (s *Server) Shutdown() {
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(s.shutdownTimeout):
+		s.server.Stop()
+	}
+}
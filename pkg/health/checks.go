@@ -0,0 +1,53 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// DBCheck checks that database is reachable.
+func DBCheck(db *sql.DB) Check {
+	return func(ctx context.Context) error {
+		return db.PingContext(ctx)
+	}
+}
+
+// MigrationsCheck checks that golang-migrate applied migrations up to the expected version and left database clean.
+func MigrationsCheck(db *sql.DB, expected uint) Check {
+	return func(ctx context.Context) error {
+		var (
+			version uint
+			dirty   bool
+		)
+
+		err := db.QueryRowContext(ctx, `select version, dirty from schema_migrations limit 1`).Scan(&version, &dirty)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("no migrations applied, expected version %d", expected)
+		}
+		if err != nil {
+			return err
+		}
+
+		if dirty {
+			return fmt.Errorf("migration %d is dirty", version)
+		}
+		if version != expected {
+			return fmt.Errorf("migration version %d, expected %d", version, expected)
+		}
+
+		return nil
+	}
+}
+
+// WorkerCheck checks that background worker is still running.
+func WorkerCheck(running func() bool) Check {
+	return func(context.Context) error {
+		if !running() {
+			return errors.New("worker is not running")
+		}
+
+		return nil
+	}
+}
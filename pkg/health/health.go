@@ -0,0 +1,169 @@
+// Package health implements liveness and readiness checks of the application.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const defaultCheckTimeout = 2 * time.Second
+
+// Status of the whole application or of a single component.
+type Status string
+
+const (
+	StatusOK      Status = "ok"
+	StatusFailing Status = "failing"
+)
+
+// Check reports a component problem by returning an error.
+type Check func(ctx context.Context) error
+
+// ComponentReport is the result of a single component check.
+type ComponentReport struct {
+	Name      string  `json:"name"`
+	Status    Status  `json:"status"`
+	LatencyMs float64 `json:"latencyMs"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Report is the detailed health report.
+type Report struct {
+	Status     Status            `json:"status"`
+	Draining   bool              `json:"draining"`
+	Components []ComponentReport `json:"components"`
+}
+
+type component struct {
+	name  string
+	check Check
+}
+
+// Checker runs registered component checks. Readiness fails when any of them fails or when the server is draining.
+type Checker struct {
+	mu         sync.RWMutex
+	components []component
+	draining   atomic.Bool
+	timeout    time.Duration
+}
+
+// NewChecker creates checker without components.
+func NewChecker() *Checker {
+	return &Checker{timeout: defaultCheckTimeout}
+}
+
+// Register adds component check. Checks are run in registration order.
+func (c *Checker) Register(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.components = append(c.components, component{name: name, check: check})
+}
+
+// StartDraining makes readiness fail, so load balancer stops sending new requests while the server is shutting down.
+func (c *Checker) StartDraining() {
+	c.draining.Store(true)
+}
+
+// Draining reports whether the server is shutting down.
+func (c *Checker) Draining() bool {
+	return c.draining.Load()
+}
+
+// Report runs all checks concurrently and collects their results.
+func (c *Checker) Report(ctx context.Context) Report {
+	c.mu.RLock()
+	components := make([]component, len(c.components))
+	copy(components, c.components)
+	c.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	reports := make([]ComponentReport, len(components))
+
+	var wg sync.WaitGroup
+	for i, comp := range components {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reports[i] = runCheck(ctx, comp)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{
+		Status:     StatusOK,
+		Draining:   c.Draining(),
+		Components: reports,
+	}
+	if report.Draining {
+		report.Status = StatusFailing
+	}
+	for _, r := range reports {
+		if r.Status != StatusOK {
+			report.Status = StatusFailing
+		}
+	}
+
+	return report
+}
+
+func runCheck(ctx context.Context, comp component) ComponentReport {
+	start := time.Now()
+	err := comp.check(ctx)
+
+	report := ComponentReport{
+		Name:      comp.name,
+		Status:    StatusOK,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		report.Status = StatusFailing
+		report.Error = err.Error()
+	}
+
+	return report
+}
+
+// LivenessHandler responds successfully while the process is able to serve HTTP requests.
+func LivenessHandler(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, struct {
+		Status Status `json:"status"`
+	}{Status: StatusOK})
+}
+
+// ReadinessHandler responds with 503 when any component fails or the server is draining.
+func (c *Checker) ReadinessHandler(w http.ResponseWriter, r *http.Request) {
+	report := c.Report(r.Context())
+
+	writeJSON(w, statusCode(report), struct {
+		Status Status `json:"status"`
+	}{Status: report.Status})
+}
+
+// ReportHandler responds with the detailed report including per-component latency.
+func (c *Checker) ReportHandler(w http.ResponseWriter, r *http.Request) {
+	report := c.Report(r.Context())
+
+	writeJSON(w, statusCode(report), report)
+}
+
+func statusCode(report Report) int {
+	if report.Status != StatusOK {
+		return http.StatusServiceUnavailable
+	}
+
+	return http.StatusOK
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
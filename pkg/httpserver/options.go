@@ -34,3 +34,17 @@ func ShutdownTimeout(timeout time.Duration) Option {
 		s.shutdownTimeout = timeout
 	}
 }
+
+// DrainDelay sets how long the server keeps accepting requests after shutdown hooks are run.
+func DrainDelay(delay time.Duration) Option {
+	return func(s *Server) {
+		s.drainDelay = delay
+	}
+}
+
+// OnShutdown adds hook that is run at the beginning of Shutdown.
+func OnShutdown(hook func()) Option {
+	return func(s *Server) {
+		s.onShutdown = append(s.onShutdown, hook)
+	}
+}
@@ -4,6 +4,7 @@ package httpserver
 import (
 	"context"
 	"net/http"
+	"sync/atomic"
 	"time"
 )
 
@@ -19,6 +20,9 @@ type Server struct {
 	server          *http.Server
 	notify          chan error
 	shutdownTimeout time.Duration
+	drainDelay      time.Duration
+	onShutdown      []func()
+	running         atomic.Bool
 }
 
 // New -.
@@ -47,8 +51,12 @@ func New(handler http.Handler, opts ...Option) *Server {
 }
 
 func (s *Server) start() {
+	s.running.Store(true)
+
 	go func() {
-		s.notify <- s.server.ListenAndServe()
+		err := s.server.ListenAndServe()
+		s.running.Store(false)
+		s.notify <- err
 		close(s.notify)
 	}()
 }
@@ -58,8 +66,20 @@ func (s *Server) Notify() <-chan error {
 	return s.notify
 }
 
-// Shutdown -.
+// Running reports whether the server still accepts connections.
+func (s *Server) Running() bool {
+	return s.running.Load()
+}
+
+// Shutdown runs shutdown hooks, waits for the drain delay and gracefully stops the server.
 func (s *Server) Shutdown() error {
+	for _, hook := range s.onShutdown {
+		hook()
+	}
+
+	// Let load balancer notice failing readiness before listener is closed.
+	time.Sleep(s.drainDelay)
+
 	ctx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
 	defer cancel()
 
@@ -0,0 +1,107 @@
+// Package i18n translates user facing messages. English is the source language: messages are written in English in
+// code and translated by message catalogues from locales directory, untranslated messages are left in English.
+package i18n
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/invopop/validation"
+	"golang.org/x/text/language"
+)
+
+// Lang is the language of messages.
+type Lang string
+
+const (
+	English Lang = "en"
+	Russian Lang = "ru"
+)
+
+// supported languages, the first one is used when nothing else matches.
+var supported = []language.Tag{language.English, language.Russian}
+
+var matcher = language.NewMatcher(supported)
+
+//go:embed locales/*.json
+var locales embed.FS
+
+// catalogue contains translations of messages keyed by English message
+// and translations of validation message templates keyed by validation error code.
+type catalogue struct {
+	Messages   map[string]string `json:"messages"`
+	Validation map[string]string `json:"validation"`
+}
+
+var catalogues = mustLoadCatalogues()
+
+func mustLoadCatalogues() map[Lang]catalogue {
+	files, err := locales.ReadDir("locales")
+	if err != nil {
+		panic(fmt.Sprintf("failed to read locales: %v", err))
+	}
+
+	result := make(map[Lang]catalogue, len(files))
+	for _, file := range files {
+		data, err := locales.ReadFile(path.Join("locales", file.Name()))
+		if err != nil {
+			panic(fmt.Sprintf("failed to read locale %s: %v", file.Name(), err))
+		}
+
+		var c catalogue
+		if err := json.Unmarshal(data, &c); err != nil {
+			panic(fmt.Sprintf("failed to parse locale %s: %v", file.Name(), err))
+		}
+
+		result[Lang(strings.TrimSuffix(file.Name(), path.Ext(file.Name())))] = c
+	}
+
+	return result
+}
+
+// Negotiate returns the best supported language for Accept-Language header value.
+func Negotiate(acceptLanguage string) Lang {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return English
+	}
+
+	_, index, _ := matcher.Match(tags...)
+	base, _ := supported[index].Base()
+
+	return Lang(base.String())
+}
+
+// Translate returns translation of the English message or message itself if there is no translation.
+func Translate(lang Lang, message string) string {
+	if translation, ok := catalogues[lang].Messages[message]; ok {
+		return translation
+	}
+
+	return message
+}
+
+// TranslateValidation returns translated message of validation error.
+func TranslateValidation(lang Lang, err validation.Error) string {
+	text, ok := catalogues[lang].Validation[err.Code()]
+	if !ok {
+		return err.Error()
+	}
+
+	tmpl, parseErr := template.New(err.Code()).Parse(text)
+	if parseErr != nil {
+		return err.Error()
+	}
+
+	var buf bytes.Buffer
+	if execErr := tmpl.Execute(&buf, err.Params()); execErr != nil {
+		return err.Error()
+	}
+
+	return buf.String()
+}
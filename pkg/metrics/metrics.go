@@ -0,0 +1,86 @@
+// Package metrics contains Prometheus metrics of the application.
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// HTTP metrics, route is the chi route pattern, so path parameters don't blow up cardinality.
+var (
+	HTTPRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Number of handled HTTP requests.",
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Duration of HTTP requests handling.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+)
+
+// Business metrics, they are incremented only after transaction is committed.
+var (
+	TendersCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tenders_created_total",
+		Help: "Number of created tenders.",
+	})
+
+	TendersPublished = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tenders_published_total",
+		Help: "Number of published tenders.",
+	})
+
+	TendersClosed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tenders_closed_total",
+		Help: "Number of closed tenders.",
+	})
+
+	BidsSubmitted = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "bids_submitted_total",
+		Help: "Number of submitted bids.",
+	})
+
+	BidsApproved = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "bids_approved_total",
+		Help: "Number of approved bids.",
+	})
+
+	BidsRejected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "bids_rejected_total",
+		Help: "Number of rejected bids.",
+	})
+
+	ApprovalVotes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bid_approval_votes_total",
+		Help: "Number of decisions submitted by tender organization responsible.",
+	}, []string{"decision"})
+
+	BidConflicts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bid_conflicts_of_interest_total",
+		Help: "Number of bids refused because of conflict of interest.",
+	}, []string{"reason"})
+)
+
+// TransactionDuration is the duration of database transactions from begin to commit or rollback.
+var TransactionDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "db_transaction_duration_seconds",
+	Help:    "Duration of database transactions.",
+	Buckets: prometheus.DefBuckets,
+}, []string{"result"})
+
+// RegisterDB registers collector of database connection pool statistics.
+func RegisterDB(db *sql.DB, dbName string) error {
+	return prometheus.Register(collectors.NewDBStatsCollector(db, dbName))
+}
+
+// Handler returns handler that exposes metrics.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
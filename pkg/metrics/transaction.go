@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+)
+
+const (
+	resultCommit   = "commit"
+	resultRollback = "rollback"
+)
+
+// InstrumentTrFactory wraps transaction factory to observe duration of transactions.
+func InstrumentTrFactory(factory trm.TrFactory) trm.TrFactory {
+	return func(ctx context.Context, s trm.Settings) (context.Context, trm.Transaction, error) {
+		ctx, tr, err := factory(ctx, s)
+		if err != nil {
+			return ctx, tr, err
+		}
+
+		return ctx, &transaction{tr: tr, start: time.Now()}, nil
+	}
+}
+
+// transaction delegates to the wrapped transaction, so drivers get the real transaction.
+type transaction struct {
+	tr    trm.Transaction
+	start time.Time
+}
+
+func (t *transaction) Transaction() interface{} {
+	return t.tr.Transaction()
+}
+
+func (t *transaction) IsActive() bool {
+	return t.tr.IsActive()
+}
+
+func (t *transaction) Closed() <-chan struct{} {
+	return t.tr.Closed()
+}
+
+func (t *transaction) Commit(ctx context.Context) error {
+	err := t.tr.Commit(ctx)
+	t.observe(resultCommit, err)
+
+	return err
+}
+
+func (t *transaction) Rollback(ctx context.Context) error {
+	err := t.tr.Rollback(ctx)
+	t.observe(resultRollback, err)
+
+	return err
+}
+
+func (t *transaction) observe(result string, err error) {
+	if err != nil {
+		result += "_error"
+	}
+
+	TransactionDuration.WithLabelValues(result).Observe(time.Since(t.start).Seconds())
+}
@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// integrityConstraintViolationClass is the class of SQLSTATE codes of violated constraints: foreign keys,
+// unique and check constraints.
+const integrityConstraintViolationClass = "23"
+
+// IsConstraintViolation reports whether the query failed because it violated an integrity constraint.
+func IsConstraintViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return strings.HasPrefix(pgErr.Code, integrityConstraintViolationClass)
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/XSAM/otelsql"
 	"github.com/jmoiron/sqlx"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 
 	// for the sake of pgx compatibility.
 	_ "github.com/jackc/pgx/v5/stdlib"
@@ -13,7 +15,7 @@ import (
 const (
 	defaultMaxOpenConns    = 30
 	defaultMaxIdleConns    = 15
-	defaultMaxConnLifetime = 180
+	defaultMaxConnLifetime = 180 * time.Second
 )
 
 // Opts represents options to initializes new postgresql wrapper.
@@ -23,6 +25,11 @@ type Opts struct {
 	Name     string
 	Username string
 	Password string
+
+	// Pool settings, zero values are replaced with defaults.
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
 }
 
 // New initializes a new postgresql wrapper and verifies that connection is stable.
@@ -36,14 +43,29 @@ func New(opts *Opts) (*sqlx.DB, error) {
 		opts.Password,
 	)
 
-	db, err := sqlx.Connect("pgx", dsn)
+	// Driver is wrapped to trace every query.
+	sqlDB, err := otelsql.Open("pgx", dsn, otelsql.WithAttributes(semconv.DBSystemPostgreSQL))
 	if err != nil {
 		return nil, fmt.Errorf("failed to init db connection: %w", err)
 	}
 
-	db.SetMaxOpenConns(defaultMaxOpenConns)
-	db.SetConnMaxLifetime(time.Duration(defaultMaxConnLifetime) * time.Second)
-	db.SetMaxIdleConns(defaultMaxIdleConns)
+	db := sqlx.NewDb(sqlDB, "pgx")
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to init db connection: %w", err)
+	}
+
+	db.SetMaxOpenConns(valueOrDefault(opts.MaxOpenConns, defaultMaxOpenConns))
+	db.SetConnMaxLifetime(valueOrDefault(opts.ConnMaxLifetime, defaultMaxConnLifetime))
+	db.SetMaxIdleConns(valueOrDefault(opts.MaxIdleConns, defaultMaxIdleConns))
 
 	return db, nil
 }
+
+func valueOrDefault[T int | time.Duration](value, def T) T {
+	if value <= 0 {
+		return def
+	}
+
+	return value
+}
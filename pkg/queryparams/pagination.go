@@ -25,36 +25,50 @@ type Pagination struct {
 }
 
 func ParseQueryPagination(values url.Values) (Pagination, error) {
-	pagination := Pagination{
-		Limit:  DefaultLimit,
-		Offset: DefaultOffset,
-	}
+	var limit, offset *int
 
-	limit, offset := values.Get("limit"), values.Get("offset")
-	if limit != "" {
-		parsedLimit, err := strconv.Atoi(limit)
+	if value := values.Get("limit"); value != "" {
+		parsedLimit, err := strconv.Atoi(value)
 		if err != nil {
 			return Pagination{}, apperror.BadRequest(errors.New("limit is not number"))
 		}
 
-		if parsedLimit < minLimit || parsedLimit > maxLimit {
-			return Pagination{}, apperror.BadRequest(fmt.Errorf("limit must be between %d and %d", minLimit, maxLimit))
-		}
-
-		pagination.Limit = parsedLimit
+		limit = &parsedLimit
 	}
 
-	if offset != "" {
-		parsedOffset, err := strconv.Atoi(offset)
+	if value := values.Get("offset"); value != "" {
+		parsedOffset, err := strconv.Atoi(value)
 		if err != nil {
 			return Pagination{}, apperror.BadRequest(apperror.ErrInvalidInput)
 		}
 
-		if parsedOffset < minOffset {
+		offset = &parsedOffset
+	}
+
+	return NewPagination(limit, offset)
+}
+
+// NewPagination checks optional limit and offset, omitted values are replaced with defaults.
+func NewPagination[T ~int | ~int32](limit, offset *T) (Pagination, error) {
+	pagination := Pagination{
+		Limit:  DefaultLimit,
+		Offset: DefaultOffset,
+	}
+
+	if limit != nil {
+		if *limit < T(minLimit) || *limit > T(maxLimit) {
+			return Pagination{}, apperror.BadRequest(fmt.Errorf("limit must be between %d and %d", minLimit, maxLimit))
+		}
+
+		pagination.Limit = int(*limit)
+	}
+
+	if offset != nil {
+		if *offset < T(minOffset) {
 			return Pagination{}, apperror.BadRequest(fmt.Errorf("offset cannot be less than %d", minOffset))
 		}
 
-		pagination.Offset = parsedOffset
+		pagination.Offset = int(*offset)
 	}
 
 	return pagination, nil
@@ -0,0 +1,196 @@
+// Package specvalidator checks that API requests and responses conform to the OpenAPI spec.
+//
+// Validation happens after the handler has responded, so it never changes responses. It is meant for tests and
+// staging environments, where divergences between handlers and the spec are reported instead of rejected.
+package specvalidator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"avito-tenders/pkg/fwcontext"
+)
+
+// Reporter receives divergence between the handler of the request and the spec.
+type Reporter func(r *http.Request, err error)
+
+// LogReporter logs divergences as warnings.
+func LogReporter(r *http.Request, err error) {
+	fwcontext.GetLogger(r.Context()).Warn("OpenAPI spec violation", "error", err)
+}
+
+// Validator matches requests to the spec operations by chi route patterns.
+type Validator struct {
+	doc      *openapi3.T
+	basePath string
+	report   Reporter
+}
+
+// New creates validator for the spec, paths of the spec are relative to the path of its first server.
+func New(doc *openapi3.T, report Reporter) (*Validator, error) {
+	basePath, err := doc.Servers.BasePath()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get base path of the spec: %w", err)
+	}
+
+	return &Validator{
+		doc:      doc,
+		basePath: strings.TrimSuffix(basePath, "/"),
+		report:   report,
+	}, nil
+}
+
+// Middleware validates requests and responses of chi routes. It reports routes missing from the spec, requests that
+// violate the spec but were accepted by the handler and responses that do not conform to the spec.
+func (v *Validator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestBody, err := io.ReadAll(r.Body)
+		if err != nil {
+			v.report(r, fmt.Errorf("failed to read request body: %w", err))
+		}
+		r.Body = io.NopCloser(bytes.NewReader(requestBody))
+
+		responseBody := &bytes.Buffer{}
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		ww.Tee(responseBody)
+
+		next.ServeHTTP(ww, r)
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		if err := v.validate(r, requestBody, status, ww.Header(), responseBody.Bytes()); err != nil {
+			v.report(r, err)
+		}
+	})
+}
+
+func (v *Validator) validate(r *http.Request, requestBody []byte, status int, header http.Header, responseBody []byte) error {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil || rctx.RoutePattern() == "" {
+		// Request is not routed to any handler.
+		return nil
+	}
+
+	pattern := rctx.RoutePattern()
+	if !strings.HasPrefix(pattern, v.basePath+"/") {
+		return fmt.Errorf("route %s is outside of the spec base path %s", pattern, v.basePath)
+	}
+
+	path := strings.TrimPrefix(pattern, v.basePath)
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+
+	pathItem := v.doc.Paths.Find(path)
+	if pathItem == nil {
+		return fmt.Errorf("route %s is not documented", pattern)
+	}
+
+	operation := pathItem.GetOperation(r.Method)
+	if operation == nil {
+		return fmt.Errorf("method %s of route %s is not documented", r.Method, pattern)
+	}
+
+	pathParams := make(map[string]string, len(rctx.URLParams.Keys))
+	for i, key := range rctx.URLParams.Keys {
+		pathParams[key] = rctx.URLParams.Values[i]
+	}
+
+	request := r.Clone(r.Context())
+	request.Body = io.NopCloser(bytes.NewReader(requestBody))
+
+	// Handlers decode JSON bodies regardless of the content type, so a missing one is not a violation.
+	if request.Header.Get("Content-Type") == "" && len(requestBody) != 0 {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	requestInput := &openapi3filter.RequestValidationInput{
+		Request:    request,
+		PathParams: pathParams,
+		Route: &routers.Route{
+			Spec:      v.doc,
+			Path:      path,
+			PathItem:  pathItem,
+			Method:    r.Method,
+			Operation: operation,
+		},
+		Options: &openapi3filter.Options{
+			MultiError:         true,
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		},
+	}
+
+	var errs []error
+
+	// Handlers may reject more than the spec does, e.g. by business rules, but must not accept what the spec rejects.
+	if err := openapi3filter.ValidateRequest(r.Context(), requestInput); err != nil && status < http.StatusBadRequest {
+		errs = append(errs, fmt.Errorf("request to %s %s violates the spec but is accepted with status %d: %w", r.Method, pattern, status, err))
+	}
+
+	responseInput := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: requestInput,
+		Status:                 status,
+		Header:                 header,
+		Options: &openapi3filter.Options{
+			MultiError:            true,
+			IncludeResponseStatus: true,
+		},
+	}
+	responseInput.SetBodyBytes(responseBody)
+
+	// Bodies of arbitrary types, e.g. downloaded files, cannot be decoded, so only their content type is checked.
+	if !decodable(header.Get("Content-Type")) {
+		responseInput.Options.ExcludeResponseBody = true
+		if err := checkContentType(operation, status, header); err != nil {
+			errs = append(errs, fmt.Errorf("response of %s %s: %w", r.Method, pattern, err))
+		}
+	}
+
+	if err := openapi3filter.ValidateResponse(r.Context(), responseInput); err != nil {
+		errs = append(errs, fmt.Errorf("response of %s %s violates the spec: %w", r.Method, pattern, err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// decodable reports whether body of the content type can be validated against the schema.
+func decodable(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return openapi3filter.RegisteredBodyDecoder(mediaType) != nil
+}
+
+// checkContentType checks that the response content type is documented for the status.
+func checkContentType(operation *openapi3.Operation, status int, header http.Header) error {
+	response := operation.Responses.Status(status)
+	if response == nil {
+		response = operation.Responses.Default()
+	}
+	if response == nil || response.Value == nil || len(response.Value.Content) == 0 {
+		return nil
+	}
+
+	contentType := header.Get("Content-Type")
+	if response.Value.Content.Get(contentType) == nil {
+		return fmt.Errorf("content type %q is not documented for status %d", contentType, status)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentTrFactory wraps transaction factory to trace transactions from begin to commit or rollback.
+// Queries made inside of transaction become children of its span.
+func InstrumentTrFactory(factory trm.TrFactory) trm.TrFactory {
+	return func(ctx context.Context, s trm.Settings) (context.Context, trm.Transaction, error) {
+		ctx, span := Tracer().Start(ctx, "db.transaction")
+
+		ctx, tr, err := factory(ctx, s)
+		if err != nil {
+			RecordError(span, err)
+			span.End()
+
+			return ctx, tr, err
+		}
+
+		return ctx, &transaction{tr: tr, span: span}, nil
+	}
+}
+
+// transaction delegates to the wrapped transaction, so drivers get the real transaction.
+type transaction struct {
+	tr   trm.Transaction
+	span trace.Span
+}
+
+func (t *transaction) Transaction() interface{} {
+	return t.tr.Transaction()
+}
+
+func (t *transaction) IsActive() bool {
+	return t.tr.IsActive()
+}
+
+func (t *transaction) Closed() <-chan struct{} {
+	return t.tr.Closed()
+}
+
+func (t *transaction) Commit(ctx context.Context) error {
+	err := t.tr.Commit(ctx)
+	t.end("commit", err)
+
+	return err
+}
+
+func (t *transaction) Rollback(ctx context.Context) error {
+	err := t.tr.Rollback(ctx)
+	t.end("rollback", err)
+
+	return err
+}
+
+func (t *transaction) end(result string, err error) {
+	t.span.SetAttributes(attribute.String("db.transaction.result", result))
+	if err != nil {
+		RecordError(t.span, err)
+	}
+
+	t.span.End()
+}
@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/stretchr/testify/require"
+
+	"avito-tenders/internal/api/attachments/dtos"
+	tendersDtos "avito-tenders/internal/api/tenders/dtos"
+)
+
+func (s *TestSuite) TestAttachments() {
+	t := s.T()
+
+	// Create published tender.
+	res := s.doRequest(t, http.MethodPost, "/api/tenders/new", nil, s.loadBody("attachments/create_tender.json"), http.StatusOK)
+	tender := decodeResponse[tendersDtos.TenderResponse](t, res)
+
+	tenderPath := fmt.Sprintf("/api/attachments/tender/%s", tender.ID)
+	specification := []byte(s.loader.LoadString(fixturesPath + "/attachments/specification.txt"))
+
+	// Only responsible users can attach files to tender.
+	s.uploadFile(t, tenderPath, userQuery("user1"), "specification.txt", specification, http.StatusForbidden)
+
+	res = s.uploadFile(t, tenderPath, userQuery("user4"), "specification.txt", specification, http.StatusOK)
+	spec := decodeResponse[dtos.AttachmentResponse](t, res)
+
+	hash := sha256.Sum256(specification)
+	require.Equal(t, hex.EncodeToString(hash[:]), spec.SHA256)
+	require.Equal(t, "text/plain", spec.ContentType)
+	require.Equal(t, int64(len(specification)), spec.Size)
+	require.Equal(t, 2, spec.Version)
+
+	// Files with not allowed type and too large files are rejected.
+	s.uploadFile(t, tenderPath, userQuery("user4"), "program.bin", []byte{0x00, 0x01, 0x02, 0xff}, http.StatusBadRequest)
+	s.uploadFile(t, tenderPath, userQuery("user4"), "large.txt", bytes.Repeat([]byte("a"), 2048), http.StatusRequestEntityTooLarge)
+
+	res = s.uploadFile(t, tenderPath, userQuery("user5"), "notes.txt", []byte("Delivery until the end of month"), http.StatusOK)
+	notes := decodeResponse[dtos.AttachmentResponse](t, res)
+	require.Equal(t, 3, notes.Version)
+
+	// Published tender attachments are visible for everyone.
+	res = s.doRequest(t, http.MethodGet, tenderPath, userQuery("user1"), nil, http.StatusOK)
+	require.Len(t, decodeResponse[[]dtos.AttachmentResponse](t, res), 2)
+
+	res = s.doRequest(t, http.MethodGet, tenderPath, url.Values{"username": []string{"user1"}, "version": []string{"2"}}, nil, http.StatusOK)
+	snapshot := decodeResponse[[]dtos.AttachmentResponse](t, res)
+	require.Len(t, snapshot, 1)
+	require.Equal(t, spec.ID, snapshot[0].ID)
+
+	res = s.doRequest(t, http.MethodGet, fmt.Sprintf("/api/attachments/%s/download", spec.ID), userQuery("user1"), nil, http.StatusOK)
+	require.Equal(t, "text/plain", res.Header.Get("Content-Type"))
+	content, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.Equal(t, specification, content)
+
+	// Rollback of tender restores its attachments snapshot.
+	s.doRequest(t, http.MethodPut, fmt.Sprintf("/api/tenders/%s/rollback/2", tender.ID), userQuery("user4"), nil, http.StatusOK)
+
+	res = s.doRequest(t, http.MethodGet, tenderPath, userQuery("user4"), nil, http.StatusOK)
+	current := decodeResponse[[]dtos.AttachmentResponse](t, res)
+	require.Len(t, current, 1)
+	require.Equal(t, spec.SHA256, current[0].SHA256)
+
+	// Removed attachment stays in previous versions.
+	s.doRequest(t, http.MethodDelete, fmt.Sprintf("/api/attachments/%s", current[0].ID), userQuery("user1"), nil, http.StatusForbidden)
+	s.doRequest(t, http.MethodDelete, fmt.Sprintf("/api/attachments/%s", current[0].ID), userQuery("user4"), nil, http.StatusOK)
+
+	res = s.doRequest(t, http.MethodGet, tenderPath, userQuery("user4"), nil, http.StatusOK)
+	require.Empty(t, decodeResponse[[]dtos.AttachmentResponse](t, res))
+
+	res = s.doRequest(t, http.MethodGet, tenderPath, url.Values{"username": []string{"user4"}, "version": []string{"3"}}, nil, http.StatusOK)
+	require.Len(t, decodeResponse[[]dtos.AttachmentResponse](t, res), 2)
+}
@@ -0,0 +1,212 @@
+package tests
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	bidsDtos "avito-tenders/internal/api/bids/dtos"
+	tendersDtos "avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/client"
+	"avito-tenders/pkg/metrics"
+)
+
+func (s *TestSuite) TestAwardWithdrawal() {
+	t := s.T()
+	ctx := context.Background()
+	c := s.newClient()
+
+	tender := s.createTender(t, c, "awards/create_tender.json")
+
+	newBid := func(name string) bidsDtos.BidResponse {
+		return s.createPublishedBid(t, c, "awards/create_bid.json", "user1", map[string]interface{}{
+			"name":     name,
+			"tenderId": tender.ID,
+		})
+	}
+	winner, third, runnerUp := newBid("Winner"), newBid("Third"), newBid("Runner-up")
+
+	// Runner-up has more approvals than the earlier bid.
+	_, err := c.SubmitDecision(ctx, runnerUp.ID, entity.DecisionApproved, "user4")
+	require.NoError(t, err)
+
+	for _, username := range []string{"user4", "user5", "user6"} {
+		_, err = c.SubmitDecision(ctx, winner.ID, entity.DecisionApproved, username)
+		require.NoError(t, err)
+	}
+
+	status, err := c.TenderStatus(ctx, tender.ID, "user4")
+	require.NoError(t, err)
+	require.Equal(t, entity.TenderClosed, status)
+
+	_, err = c.DeclineAward(ctx, winner.ID, "", "user4")
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	award, err := c.DeclineAward(ctx, winner.ID, "Not enough trucks", "user1")
+	require.NoError(t, err)
+	require.Equal(t, entity.BidDeclined, award.Bid.Status)
+	require.NotNil(t, award.OfferedBid)
+	require.Equal(t, runnerUp.ID, award.OfferedBid.ID)
+	require.Equal(t, entity.BidOffered, award.OfferedBid.Status)
+
+	// Tender stays closed for new bids while the offer is pending.
+	require.Equal(t, entity.TenderClosed, award.TenderStatus)
+
+	_, err = c.CreateBid(ctx, loadFixture[bidsDtos.CreateBidRequest](s, t, "awards/create_bid.json", map[string]interface{}{
+		"name":     "Late bid",
+		"tenderId": tender.ID,
+	}))
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	_, err = c.DeclineAward(ctx, winner.ID, "", "user1")
+	require.ErrorIs(t, err, client.ErrInvalidInput)
+
+	// Organization cancels the offered award, the next bid is offered it.
+	_, err = c.CancelAward(ctx, runnerUp.ID, "Price changed", "user1")
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	_, err = c.CancelAward(ctx, runnerUp.ID, "", "user5")
+	require.ErrorIs(t, err, client.ErrValidationFailed)
+
+	award, err = c.CancelAward(ctx, runnerUp.ID, "Price changed", "user5")
+	require.NoError(t, err)
+	require.Equal(t, entity.BidRevoked, award.Bid.Status)
+	require.Equal(t, third.ID, award.OfferedBid.ID)
+
+	_, err = c.AcceptAward(ctx, winner.ID, "user1")
+	require.ErrorIs(t, err, client.ErrInvalidInput)
+
+	award, err = c.AcceptAward(ctx, third.ID, "user1")
+	require.NoError(t, err)
+	require.Equal(t, entity.BidApproved, award.Bid.Status)
+	require.Equal(t, entity.TenderClosed, award.TenderStatus)
+
+	// Without bids left the tender reopens.
+	award, err = c.DeclineAward(ctx, third.ID, "", "user1")
+	require.NoError(t, err)
+	require.Nil(t, award.OfferedBid)
+	require.Equal(t, entity.TenderPublished, award.TenderStatus)
+
+	status, err = c.TenderStatus(ctx, tender.ID, "user4")
+	require.NoError(t, err)
+	require.Equal(t, entity.TenderPublished, status)
+}
+
+func (s *TestSuite) TestAwardOfferOfClosedTender() {
+	t := s.T()
+	ctx := context.Background()
+	c := s.newClient()
+
+	tender := s.createTender(t, c, "awards/create_tender.json")
+
+	newBid := func(name string) bidsDtos.BidResponse {
+		return s.createPublishedBid(t, c, "awards/create_bid.json", "user1", map[string]interface{}{
+			"name":     name,
+			"tenderId": tender.ID,
+		})
+	}
+	winner, runnerUp := newBid("Winner"), newBid("Runner-up")
+
+	for _, username := range []string{"user4", "user5", "user6"} {
+		_, err := c.SubmitDecision(ctx, winner.ID, entity.DecisionApproved, username)
+		require.NoError(t, err)
+	}
+
+	award, err := c.DeclineAward(ctx, winner.ID, "", "user1")
+	require.NoError(t, err)
+	require.Equal(t, runnerUp.ID, award.OfferedBid.ID)
+
+	// Organization reopens and closes the tender again, the offer can't be accepted anymore.
+	_, err = c.UpdateTenderStatus(ctx, tender.ID, entity.TenderPublished, "user4")
+	require.NoError(t, err)
+
+	_, err = c.UpdateTenderStatus(ctx, tender.ID, entity.TenderClosed, "user4")
+	require.NoError(t, err)
+
+	_, err = c.AcceptAward(ctx, runnerUp.ID, "user1")
+	require.ErrorIs(t, err, client.ErrInvalidInput)
+}
+
+func (s *TestSuite) TestCompetingBidsRejectedOnAward() {
+	t := s.T()
+	ctx := context.Background()
+	c := s.newClient()
+
+	tender := s.createTender(t, c, "awards/create_exclusive_tender.json")
+
+	settings, err := c.AwardSettings(ctx, tender.ID, "user4")
+	require.NoError(t, err)
+	require.False(t, settings.RejectCompetingBids)
+
+	feedback := "Thank you, another offer has won"
+	body := tendersDtos.AwardSettingsBody{RejectCompetingBids: true, RejectionFeedback: &feedback}
+
+	_, err = c.SetAwardSettings(ctx, tender.ID, "user1", body)
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	settings, err = c.SetAwardSettings(ctx, tender.ID, "user4", body)
+	require.NoError(t, err)
+	require.True(t, settings.RejectCompetingBids)
+
+	_, err = c.UpdateTenderStatus(ctx, tender.ID, entity.TenderPublished, "user4")
+	require.NoError(t, err)
+
+	bidData := func(name string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "tenderId": tender.ID}
+	}
+	winner := s.createPublishedBid(t, c, "awards/create_bid.json", "user1", bidData("Winner"))
+	s.createPublishedBid(t, c, "awards/create_bid.json", "user1", bidData("Loser 1"))
+	s.createPublishedBid(t, c, "awards/create_bid.json", "user1", bidData("Loser 2"))
+
+	draft, err := c.CreateBid(ctx, loadFixture[bidsDtos.CreateBidRequest](s, t, "awards/create_bid.json", bidData("Draft")))
+	require.NoError(t, err)
+
+	reputation, err := c.SupplierReputation(ctx, "550e8400-e29b-41d4-a716-446655440001", "user4")
+	require.NoError(t, err)
+	rejectedCount := testutil.ToFloat64(metrics.BidsRejected)
+
+	for _, username := range []string{"user4", "user5", "user6"} {
+		_, err = c.SubmitDecision(ctx, winner.ID, entity.DecisionApproved, username)
+		require.NoError(t, err)
+	}
+
+	bidsList, err := c.TenderBidsIterator(tender.ID, "user1").All(ctx)
+	require.NoError(t, err)
+	require.Len(t, bidsList, 4)
+
+	rejected := make(map[string]struct{})
+	for _, bid := range bidsList {
+		switch bid.ID {
+		case winner.ID:
+			require.Equal(t, entity.BidApproved, bid.Status)
+		case draft.ID:
+			require.Equal(t, entity.BidCreated, bid.Status)
+		default:
+			// Rejection is a new version of the published bid.
+			require.Equal(t, entity.BidRejected, bid.Status)
+			require.Equal(t, 3, bid.Version)
+			rejected[bid.ID] = struct{}{}
+		}
+	}
+	require.Len(t, rejected, 2)
+
+	reviews, err := c.ReviewsIterator(tender.ID, "user1", "user4").All(ctx)
+	require.NoError(t, err)
+
+	var rejectionReviews int
+	for _, review := range reviews {
+		if review.Description == feedback {
+			rejectionReviews++
+		}
+	}
+	require.Equal(t, 2, rejectionReviews)
+
+	// Rejections are counted, but automatic feedback doesn't change reputation.
+	require.InDelta(t, rejectedCount+2, testutil.ToFloat64(metrics.BidsRejected), 0.001)
+
+	after, err := c.SupplierReputation(ctx, "550e8400-e29b-41d4-a716-446655440001", "user4")
+	require.NoError(t, err)
+	require.Equal(t, reputation.ReviewsCount, after.ReviewsCount)
+}
@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	trmsqlx "github.com/avito-tech/go-transaction-manager/drivers/sqlx/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+
+	"avito-tenders/internal/api/bids/dtos"
+	"avito-tenders/internal/api/bids/models"
+	bidsRepo "avito-tenders/internal/api/bids/repository"
+	bidsUsecase "avito-tenders/internal/api/bids/usecase"
+	empRepo "avito-tenders/internal/api/employee/repository"
+	orgRepo "avito-tenders/internal/api/organization/repository"
+	suppliersRepo "avito-tenders/internal/api/suppliers/repository"
+	tendersRepo "avito-tenders/internal/api/tenders/repository"
+	"avito-tenders/internal/app"
+	"avito-tenders/internal/entity"
+	"avito-tenders/internal/seed"
+	"avito-tenders/pkg/queryparams"
+)
+
+// BenchmarkFindByTenderID compares listing of tender bids with permission checks per bid, as it was done before, and
+// with visibility rules applied by a single query.
+func BenchmarkFindByTenderID(b *testing.B) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	psqlContainer, err := NewPostgreSQLContainer(ctx)
+	require.NoError(b, err)
+	b.Cleanup(func() {
+		_ = psqlContainer.Terminate(context.Background())
+	})
+
+	var db *sqlx.DB
+	for i := 0; i < 20; i++ {
+		db, err = sqlx.Connect("postgres", psqlContainer.GetDSN())
+		if err == nil {
+			break
+		}
+
+		time.Sleep(time.Second)
+	}
+	require.NoError(b, err)
+	defer db.Close()
+
+	migrator, err := app.NewMigrator(db.DB)
+	require.NoError(b, err)
+	require.NoError(b, migrator.Up())
+
+	ds, err := seed.Generate(seed.Opts{
+		Seed:                   1,
+		Employees:              300,
+		Organizations:          20,
+		MaxMembers:             5,
+		TendersPerOrganization: 2,
+		MaxBidsPerTender:       100,
+		ReviewRatio:            0,
+	})
+	require.NoError(b, err)
+
+	tx, err := db.BeginTxx(ctx, nil)
+	require.NoError(b, err)
+	require.NoError(b, seed.Insert(ctx, tx, ds))
+	require.NoError(b, tx.Commit())
+
+	tender, username := benchmarkTender(ds)
+
+	repo := bidsRepo.NewRepository(db, trmsqlx.DefaultCtxGetter)
+	orgs := orgRepo.NewRepository(db, trmsqlx.DefaultCtxGetter)
+	uc := bidsUsecase.NewUsecase(bidsUsecase.Opts{
+		Repo:         repo,
+		OrgRepo:      orgs,
+		EmpRepo:      empRepo.NewRepository(db, trmsqlx.DefaultCtxGetter),
+		TenderRepo:   tendersRepo.NewRepository(db, trmsqlx.DefaultCtxGetter),
+		SupplierRepo: suppliersRepo.NewRepository(db, trmsqlx.DefaultCtxGetter),
+	})
+	pagination := queryparams.Pagination{Limit: 50}
+
+	b.Run("per-bid-checks", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			bidsList, err := repo.FindByTenderID(ctx, models.FindByTenderID{TenderID: tender.ID, Pagination: pagination})
+			require.NoError(b, err)
+
+			isResponsible, err := orgs.IsOrganizationResponsible(ctx, tender.OrganizationID, username)
+			require.NoError(b, err)
+
+			visible := make([]entity.Bid, 0, len(bidsList))
+			for _, bid := range bidsList {
+				if isResponsible && bid.Status == entity.BidPublished {
+					visible = append(visible, bid)
+					continue
+				}
+
+				if has, _ := uc.AuthorHasPermissions(ctx, bid, username); has {
+					visible = append(visible, bid)
+				}
+			}
+		}
+	})
+
+	b.Run("single-query", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, err := uc.FindByTenderID(ctx, dtos.FindByTenderIDRequest{
+				TenderID:   tender.ID,
+				Username:   username,
+				Pagination: pagination,
+			})
+			require.NoError(b, err)
+		}
+	})
+}
+
+// benchmarkTender returns the tender with the most bids and the username of a responsible of its organization.
+func benchmarkTender(ds seed.Dataset) (seed.Tender, string) {
+	bidsCount := make(map[string]int, len(ds.Tenders))
+	for _, bid := range ds.Bids {
+		bidsCount[bid.TenderID]++
+	}
+
+	tender := ds.Tenders[0]
+	for _, t := range ds.Tenders {
+		if bidsCount[t.ID] > bidsCount[tender.ID] {
+			tender = t
+		}
+	}
+
+	usernames := make(map[string]string, len(ds.Employees))
+	for _, emp := range ds.Employees {
+		usernames[emp.ID] = emp.Username
+	}
+
+	for _, membership := range ds.Memberships {
+		if membership.OrganizationID == tender.OrganizationID {
+			return tender, usernames[membership.UserID]
+		}
+	}
+
+	return tender, ""
+}
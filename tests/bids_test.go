@@ -2,15 +2,21 @@ package tests
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"avito-tenders/internal/api/bids/dtos"
+	tendersDtos "avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/client"
+	"avito-tenders/pkg/queryparams"
 )
 
 func (s *TestSuite) TestCreateBid() {
@@ -687,3 +693,123 @@ func (s *TestSuite) TestGetMyBids() {
 // 		})
 // 	}
 // }
+
+func (s *TestSuite) TestTenderBidsPagesAreFull() {
+	t := s.T()
+	ctx := context.Background()
+	c := s.newClient()
+
+	tender, err := c.CreateTender(ctx, tendersDtos.CreateTenderRequest{
+		Name:            "Paginated tender",
+		Description:     "Has bids hidden from its organization",
+		ServiceType:     entity.ServiceConstruction,
+		OrganizationID:  "550e8400-e29b-41d4-a716-446655440021",
+		CreatorUsername: "user4",
+	})
+	require.NoError(t, err)
+
+	_, err = c.UpdateTenderStatus(ctx, tender.ID, entity.TenderPublished, "user4")
+	require.NoError(t, err)
+
+	// Drafts go first by name, they are hidden from the tender organization.
+	for _, name := range []string{"A draft", "B draft", "C published", "D published"} {
+		bid, err := c.CreateBid(ctx, dtos.CreateBidRequest{
+			Name:        name,
+			Description: "Bid of the paginated tender",
+			TenderID:    tender.ID,
+			AuthorType:  entity.AuthorUser,
+			AuthorID:    "550e8400-e29b-41d4-a716-44665544000c",
+		})
+		require.NoError(t, err)
+
+		if strings.HasSuffix(name, "published") {
+			_, err = c.UpdateBidStatus(ctx, bid.ID, entity.BidPublished, "user12")
+			require.NoError(t, err)
+		}
+	}
+
+	page, err := c.TenderBids(ctx, tender.ID, "user4", queryparams.Pagination{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, "C published", page[0].Name)
+	require.Equal(t, "D published", page[1].Name)
+
+	page, err = c.TenderBids(ctx, tender.ID, "user12", queryparams.Pagination{Limit: 3, Offset: 1})
+	require.NoError(t, err)
+	require.Len(t, page, 3)
+	require.Equal(t, "B draft", page[0].Name)
+
+	// Responsibles of other organizations see nothing.
+	page, err = c.TenderBids(ctx, tender.ID, "user7", queryparams.Pagination{Limit: 10})
+	require.NoError(t, err)
+	require.Empty(t, page)
+}
+
+func (s *TestSuite) TestAuthorReviewsAcrossTenders() {
+	t := s.T()
+	ctx := context.Background()
+	c := s.newClient()
+
+	// The author bids in tenders of two organizations, each of them leaves a review.
+	var tenderIDs []string
+	for _, org := range []struct{ id, username, feedback string }{
+		{id: "550e8400-e29b-41d4-a716-446655440021", username: "user4", feedback: "Late delivery"},
+		{id: "550e8400-e29b-41d4-a716-446655440022", username: "user7", feedback: "Great quality"},
+	} {
+		tender, err := c.CreateTender(ctx, tendersDtos.CreateTenderRequest{
+			Name:            "Reviewed tender",
+			Description:     "Tender of " + org.username,
+			ServiceType:     entity.ServiceManufacture,
+			OrganizationID:  org.id,
+			CreatorUsername: org.username,
+		})
+		require.NoError(t, err)
+		tenderIDs = append(tenderIDs, tender.ID)
+
+		_, err = c.UpdateTenderStatus(ctx, tender.ID, entity.TenderPublished, org.username)
+		require.NoError(t, err)
+
+		bid, err := c.CreateBid(ctx, dtos.CreateBidRequest{
+			Name:        "Reviewed bid",
+			Description: "Bid of user11",
+			TenderID:    tender.ID,
+			AuthorType:  entity.AuthorUser,
+			AuthorID:    "550e8400-e29b-41d4-a716-44665544000b",
+		})
+		require.NoError(t, err)
+
+		_, err = c.UpdateBidStatus(ctx, bid.ID, entity.BidPublished, "user11")
+		require.NoError(t, err)
+
+		_, err = c.SendFeedback(ctx, bid.ID, org.feedback, org.username)
+		require.NoError(t, err)
+	}
+
+	// Reviews left by other organizations are visible too, the latest first.
+	reviews, err := c.Reviews(ctx, tenderIDs[0], "user11", "user4", queryparams.Pagination{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, reviews, 2)
+	require.Equal(t, "Great quality", reviews[0].Description)
+	require.Equal(t, "Late delivery", reviews[1].Description)
+
+	reviews, err = c.Reviews(ctx, tenderIDs[0], "user11", "user4", queryparams.Pagination{Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	require.Equal(t, "Late delivery", reviews[0].Description)
+
+	summary, err := c.ReviewsSummary(ctx, tenderIDs[1], "user11", "user7")
+	require.NoError(t, err)
+	require.Equal(t, "user11", summary.AuthorUsername)
+	require.Equal(t, 2, summary.BidsCount)
+	require.Equal(t, 2, summary.TendersCount)
+	require.Equal(t, 2, summary.ReviewsCount)
+	require.Zero(t, summary.ApprovedBids)
+	require.NotNil(t, summary.LastReviewAt)
+
+	// Reviews are available to responsibles for the tender organization only.
+	_, err = c.Reviews(ctx, tenderIDs[0], "user11", "user7", queryparams.Pagination{Limit: 10})
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	_, err = c.ReviewsSummary(ctx, tenderIDs[0], "user11", "user7")
+	require.ErrorIs(t, err, client.ErrForbidden)
+}
@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	bidsDtos "avito-tenders/internal/api/bids/dtos"
+	tendersDtos "avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/client"
+	"avito-tenders/pkg/queryparams"
+)
+
+func (s *TestSuite) TestClient() {
+	t := s.T()
+	ctx := context.Background()
+
+	// Small page makes iterators fetch several pages.
+	c := s.newClient(client.PageSize(2))
+
+	// Tenders.
+	tender, err := c.CreateTender(ctx, tendersDtos.CreateTenderRequest{
+		Name:            "Client tender",
+		Description:     "Created by client",
+		ServiceType:     entity.ServiceDelivery,
+		OrganizationID:  "550e8400-e29b-41d4-a716-446655440021",
+		CreatorUsername: "user4",
+	})
+	require.NoError(t, err)
+	require.Equal(t, entity.TenderCreated, tender.Status)
+
+	tender, err = c.UpdateTenderStatus(ctx, tender.ID, entity.TenderPublished, "user4")
+	require.NoError(t, err)
+
+	status, err := c.TenderStatus(ctx, tender.ID, "user4")
+	require.NoError(t, err)
+	require.Equal(t, entity.TenderPublished, status)
+
+	tender, err = c.EditTender(ctx, tender.ID, "user4", tendersDtos.EditTender{Name: "Client tender edited"})
+	require.NoError(t, err)
+	require.Equal(t, "Client tender edited", tender.Name)
+
+	tender, err = c.RollbackTender(ctx, tender.ID, 2, "user4")
+	require.NoError(t, err)
+	require.Equal(t, "Client tender", tender.Name)
+
+	item, err := c.CreateItem(ctx, tender.ID, "user4", tendersDtos.CreateItemBody{
+		Description: "Boxes", Unit: "pcs", Quantity: decimal.NewFromInt(10),
+	})
+	require.NoError(t, err)
+
+	items, err := c.Items(ctx, tender.ID, "user4")
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+
+	lot, err := c.CreateLot(ctx, tender.ID, "user4", tendersDtos.CreateLotBody{Name: "Lot 1", Description: "Lot description"})
+	require.NoError(t, err)
+
+	canceledLot, err := c.CreateLot(ctx, tender.ID, "user4", tendersDtos.CreateLotBody{Name: "Lot 2", Description: "Lot description"})
+	require.NoError(t, err)
+
+	canceledLot, err = c.CancelLot(ctx, tender.ID, canceledLot.ID, "user4")
+	require.NoError(t, err)
+	require.Equal(t, entity.LotCancelled, canceledLot.Status)
+
+	lots, err := c.Lots(ctx, tender.ID, "user4")
+	require.NoError(t, err)
+	require.Len(t, lots, 2)
+
+	myTenders, err := c.MyTendersIterator("user4").All(ctx)
+	require.NoError(t, err)
+	firstPage, err := c.MyTenders(ctx, "user4", queryparams.Pagination{Limit: 50})
+	require.NoError(t, err)
+	require.Greater(t, len(firstPage), 2)
+	require.Equal(t, firstPage, myTenders)
+
+	// Bids.
+	bid, err := c.CreateBid(ctx, bidsDtos.CreateBidRequest{
+		Name:        "Client bid",
+		Description: "Created by client",
+		TenderID:    tender.ID,
+		AuthorType:  entity.AuthorUser,
+		AuthorID:    "550e8400-e29b-41d4-a716-44665544000c",
+		LotIDs:      []string{lot.ID},
+	})
+	require.NoError(t, err)
+
+	bid, err = c.UpdateBidStatus(ctx, bid.ID, entity.BidPublished, "user12")
+	require.NoError(t, err)
+
+	bidStatus, err := c.BidStatus(ctx, bid.ID, "user12")
+	require.NoError(t, err)
+	require.Equal(t, entity.BidPublished, bidStatus)
+
+	quotes, err := c.SetBidItems(ctx, bid.ID, "user12", []bidsDtos.BidItemQuote{{ItemID: item.ID, UnitPrice: decimal.NewFromInt(3)}})
+	require.NoError(t, err)
+	require.True(t, decimal.NewFromInt(30).Equal(quotes.Total))
+
+	comparison, err := c.CompareBidItems(ctx, tender.ID, "user4", queryparams.Pagination{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, comparison.Bids, 1)
+
+	tenderBids, err := c.TenderBidsIterator(tender.ID, "user4").All(ctx)
+	require.NoError(t, err)
+	require.Len(t, tenderBids, 1)
+
+	_, err = c.SendFeedback(ctx, bid.ID, "Good offer", "user4")
+	require.NoError(t, err)
+
+	reviews, err := c.ReviewsIterator(tender.ID, "user12", "user4").All(ctx)
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+
+	for _, username := range []string{"user4", "user5", "user6"} {
+		bid, err = c.SubmitDecision(ctx, bid.ID, entity.DecisionApproved, username)
+		require.NoError(t, err)
+	}
+	require.Equal(t, entity.BidApproved, bid.Status)
+
+	status, err = c.TenderStatus(ctx, tender.ID, "user4")
+	require.NoError(t, err)
+	require.Equal(t, entity.TenderClosed, status)
+
+	// Errors are typed.
+	_, err = c.TenderStatus(ctx, "550e8400-e29b-41d4-a716-446655449999", "user4")
+	require.ErrorIs(t, err, client.ErrNotFound)
+
+	_, err = c.MyBids(ctx, "unknown", queryparams.Pagination{Limit: 5})
+	require.ErrorIs(t, err, client.ErrUserDoesNotExist)
+
+	_, err = c.EditBid(ctx, bid.ID, "user4", bidsDtos.EditBidBody{Name: "Not mine"})
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	_, err = c.CreateTender(ctx, tendersDtos.CreateTenderRequest{Name: "No fields"})
+	require.ErrorIs(t, err, client.ErrValidationFailed)
+
+	var apiErr *client.Error
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, 400, apiErr.StatusCode)
+	require.NotEmpty(t, apiErr.Errors)
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/require"
+
+	bidsDtos "avito-tenders/internal/api/bids/dtos"
+	orgDtos "avito-tenders/internal/api/organization/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/client"
+	"avito-tenders/pkg/queryparams"
+)
+
+func (s *TestSuite) TestConflictsOfInterest() {
+	t := s.T()
+	ctx := context.Background()
+	c := s.newClient()
+
+	const (
+		organizationID  = "550e8400-e29b-41d4-a716-446655440023"
+		relatedOrgID    = "550e8400-e29b-41d4-a716-446655440020"
+		relatedUserID   = "550e8400-e29b-41d4-a716-446655440007"
+		memberID        = "550e8400-e29b-41d4-a716-44665544000b"
+		relatedMemberID = "550e8400-e29b-41d4-a716-446655440002"
+	)
+
+	tender := s.createTender(t, c, "conflicts/create_tender.json")
+
+	createBid := func(authorType entity.AuthorType, authorID string) error {
+		_, err := c.CreateBid(ctx, loadFixture[bidsDtos.CreateBidRequest](s, t, "conflicts/create_bid.json", map[string]interface{}{
+			"tenderId":   tender.ID,
+			"authorType": authorType,
+			"authorId":   authorID,
+		}))
+
+		return err
+	}
+
+	// Members of the tender organization can't bid neither as users nor as the organization.
+	require.ErrorIs(t, createBid(entity.AuthorUser, memberID), client.ErrConflictOfInterest)
+	require.ErrorIs(t, createBid(entity.AuthorOrganization, memberID), client.ErrConflictOfInterest)
+	require.NoError(t, createBid(entity.AuthorUser, relatedMemberID))
+
+	// Only responsibles declare related parties, the organization itself and unknown parties are refused.
+	_, err := c.AddRelatedParty(ctx, organizationID, "user1", orgDtos.RelatedPartyBody{PartyType: entity.AuthorOrganization, PartyID: relatedOrgID})
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	_, err = c.AddRelatedParty(ctx, organizationID, "user10", orgDtos.RelatedPartyBody{PartyType: entity.AuthorOrganization, PartyID: organizationID})
+	require.ErrorIs(t, err, client.ErrInvalidInput)
+
+	_, err = c.AddRelatedParty(ctx, organizationID, "user10", orgDtos.RelatedPartyBody{PartyType: entity.AuthorUser, PartyID: "550e8400-e29b-41d4-a716-4466554400ff"})
+	require.ErrorIs(t, err, client.ErrNotFound)
+
+	party, err := c.AddRelatedParty(ctx, organizationID, "user10", orgDtos.RelatedPartyBody{PartyType: entity.AuthorOrganization, PartyID: relatedOrgID})
+	require.NoError(t, err)
+	require.Equal(t, entity.AuthorOrganization, party.PartyType)
+
+	_, err = c.AddRelatedParty(ctx, organizationID, "user11", orgDtos.RelatedPartyBody{PartyType: entity.AuthorUser, PartyID: relatedUserID})
+	require.NoError(t, err)
+
+	parties, err := c.RelatedParties(ctx, organizationID, "user12")
+	require.NoError(t, err)
+	require.Len(t, parties, 2)
+
+	_, err = c.RelatedParties(ctx, organizationID, "user1")
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	// Members of the related organization and the related user can't bid anymore.
+	require.ErrorIs(t, createBid(entity.AuthorUser, relatedMemberID), client.ErrConflictOfInterest)
+	require.ErrorIs(t, createBid(entity.AuthorOrganization, relatedMemberID), client.ErrConflictOfInterest)
+	require.ErrorIs(t, createBid(entity.AuthorUser, relatedUserID), client.ErrConflictOfInterest)
+
+	// Every refused bid is logged for the tender organization.
+	attempts, err := c.ConflictAttempts(ctx, organizationID, "user12", queryparams.Pagination{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, attempts, 5)
+	require.Equal(t, relatedUserID, attempts[0].AuthorID)
+	require.Equal(t, entity.ConflictRelatedParty, attempts[0].Reason)
+	require.Equal(t, memberID, attempts[4].AuthorID)
+	require.Equal(t, entity.ConflictOwnOrganization, attempts[4].Reason)
+
+	_, err = c.ConflictAttempts(ctx, organizationID, "user7", queryparams.Pagination{Limit: 10})
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	// Removed parties can bid again.
+	relatedOrg := orgDtos.RelatedPartyBody{PartyType: entity.AuthorOrganization, PartyID: relatedOrgID}
+	relatedUser := orgDtos.RelatedPartyBody{PartyType: entity.AuthorUser, PartyID: relatedUserID}
+
+	// Party is removed by its type and id.
+	require.ErrorIs(t, c.RemoveRelatedParty(ctx, organizationID, orgDtos.RelatedPartyBody{PartyType: entity.AuthorUser, PartyID: relatedOrgID}, "user10"), client.ErrNotFound)
+	require.NoError(t, c.RemoveRelatedParty(ctx, organizationID, relatedOrg, "user10"))
+	require.ErrorIs(t, c.RemoveRelatedParty(ctx, organizationID, relatedOrg, "user10"), client.ErrNotFound)
+	require.NoError(t, c.RemoveRelatedParty(ctx, organizationID, relatedUser, "user10"))
+
+	require.NoError(t, createBid(entity.AuthorOrganization, relatedMemberID))
+}
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+
+	"github.com/stretchr/testify/require"
+
+	"avito-tenders/pkg/apperror"
+)
+
+func (s *TestSuite) TestProblemResponses() {
+	t := s.T()
+
+	// Validation errors are reported per field.
+	res := s.doRequest(t, http.MethodPost, "/api/tenders/new", nil,
+		bytes.NewBufferString(s.loader.LoadString(fixturesPath+"/errors/invalid_tender.json")), http.StatusBadRequest)
+	require.Equal(t, "application/problem+json", res.Header.Get("Content-Type"))
+
+	problem := decodeResponse[apperror.Problem](t, res)
+	require.Equal(t, apperror.CodeValidationFailed, problem.Code)
+	require.Equal(t, http.StatusBadRequest, problem.Status)
+	require.NotEmpty(t, problem.Reason)
+	require.NotEmpty(t, problem.RequestID)
+	require.Equal(t, res.Header.Get("X-Request-Id"), problem.RequestID)
+
+	fields := make([]string, 0, len(problem.Errors))
+	for _, violation := range problem.Errors {
+		require.NotEmpty(t, violation.Code)
+		require.NotEmpty(t, violation.Message)
+		fields = append(fields, violation.Field)
+	}
+	require.Equal(t, []string{"description", "name", "serviceType"}, fields)
+
+	// Sentinel errors have their own codes.
+	res = s.doRequest(t, http.MethodGet, "/api/tenders/my", nil, nil, http.StatusUnauthorized)
+	require.Equal(t, apperror.CodeUserRequired, decodeResponse[apperror.Problem](t, res).Code)
+
+	res = s.doRequest(t, http.MethodGet, "/api/tenders/my", url.Values{"username": []string{"unknown"}}, nil, http.StatusUnauthorized)
+	require.Equal(t, apperror.CodeUserDoesNotExist, decodeResponse[apperror.Problem](t, res).Code)
+
+	// Every code is documented in the catalogue.
+	res = s.doRequest(t, http.MethodGet, "/api/errors", nil, nil, http.StatusOK)
+	require.Len(t, decodeResponse[[]apperror.CatalogueEntry](t, res), len(apperror.Catalogue))
+}
+
+func (s *TestSuite) TestLocalizedProblemResponses() {
+	t := s.T()
+
+	send := func(acceptLanguage string) *http.Response {
+		req, err := http.NewRequest(http.MethodPost, s.server.URL+"/api/tenders/new",
+			bytes.NewBufferString(s.loader.LoadString(fixturesPath+"/errors/invalid_tender.json")))
+		require.NoError(t, err)
+		req.Header.Set("Accept-Language", acceptLanguage)
+
+		res, err := s.server.Client().Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { res.Body.Close() })
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		return res
+	}
+
+	res := send("ru-RU,ru;q=0.9,en;q=0.8")
+	require.Equal(t, "ru", res.Header.Get("Content-Language"))
+
+	problem := decodeResponse[apperror.Problem](t, res)
+	require.Equal(t, "Ошибка валидации", problem.Title)
+	require.Equal(t, apperror.Violation{
+		Field:   "description",
+		Code:    "validation_required",
+		Message: "не может быть пустым",
+	}, problem.Errors[0])
+	require.Equal(t, "длина должна быть от 3 до 50", problem.Errors[1].Message)
+
+	// Unsupported languages fall back to English.
+	res = send("de-DE")
+	require.Equal(t, "en", res.Header.Get("Content-Language"))
+	require.Equal(t, "cannot be blank", decodeResponse[apperror.Problem](t, res).Errors[0].Message)
+}
@@ -0,0 +1,323 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	bidsDtos "avito-tenders/internal/api/bids/dtos"
+	"avito-tenders/internal/api/graphql"
+	tendersDtos "avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/apperror"
+	"avito-tenders/pkg/dataloader"
+	"avito-tenders/pkg/queryparams"
+)
+
+type graphqlError struct {
+	Message    string `json:"message"`
+	Path       []any  `json:"path"`
+	Extensions struct {
+		Code   string `json:"code"`
+		Status int    `json:"status"`
+	} `json:"extensions"`
+}
+
+type graphqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []graphqlError  `json:"errors"`
+}
+
+// graphqlQuery sends GraphQL query on behalf of the user, empty username means anonymous request.
+func (s *TestSuite) graphqlQuery(t *testing.T, username, query string, variables map[string]any) graphqlResponse {
+	body, err := json.Marshal(graphql.Request{Query: query, Variables: variables})
+	require.NoError(t, err)
+
+	values := url.Values{}
+	if username != "" {
+		values.Set("username", username)
+	}
+
+	res := s.doRequest(t, http.MethodPost, "/api/graphql", values, bytes.NewReader(body), http.StatusOK)
+
+	return decodeResponse[graphqlResponse](t, res)
+}
+
+const tenderPageQuery = `query($id: ID!) {
+  tender(id: $id) {
+    name
+    status
+    organization { name responsibles { username } }
+    history { version name }
+    bids {
+      name
+      status
+      author { username organization { name } }
+      reviews { description }
+    }
+  }
+}`
+
+type tenderPage struct {
+	Tender *struct {
+		Name         string `json:"name"`
+		Status       string `json:"status"`
+		Organization struct {
+			Name         string `json:"name"`
+			Responsibles []struct {
+				Username string `json:"username"`
+			} `json:"responsibles"`
+		} `json:"organization"`
+		History []struct {
+			Version int    `json:"version"`
+			Name    string `json:"name"`
+		} `json:"history"`
+		Bids []struct {
+			Name   string `json:"name"`
+			Status string `json:"status"`
+			Author struct {
+				Username     string `json:"username"`
+				Organization *struct {
+					Name string `json:"name"`
+				} `json:"organization"`
+			} `json:"author"`
+			Reviews []struct {
+				Description string `json:"description"`
+			} `json:"reviews"`
+		} `json:"bids"`
+	} `json:"tender"`
+}
+
+func (s *TestSuite) TestGraphQL() {
+	t := s.T()
+	ctx := context.Background()
+	c := s.newClient()
+
+	tender, err := c.CreateTender(ctx, tendersDtos.CreateTenderRequest{
+		Name:            "GraphQL tender",
+		Description:     "Queried over GraphQL",
+		ServiceType:     entity.ServiceDelivery,
+		OrganizationID:  "550e8400-e29b-41d4-a716-446655440021",
+		CreatorUsername: "user4",
+	})
+	require.NoError(t, err)
+
+	tender, err = c.UpdateTenderStatus(ctx, tender.ID, entity.TenderPublished, "user4")
+	require.NoError(t, err)
+
+	published, err := c.CreateBid(ctx, bidsDtos.CreateBidRequest{
+		Name:        "Published bid",
+		Description: "Visible to the tender organization",
+		TenderID:    tender.ID,
+		AuthorType:  entity.AuthorUser,
+		AuthorID:    "550e8400-e29b-41d4-a716-44665544000c",
+	})
+	require.NoError(t, err)
+
+	_, err = c.UpdateBidStatus(ctx, published.ID, entity.BidPublished, "user12")
+	require.NoError(t, err)
+
+	_, err = c.CreateBid(ctx, bidsDtos.CreateBidRequest{
+		Name:        "Draft bid",
+		Description: "Visible to the author only",
+		TenderID:    tender.ID,
+		AuthorType:  entity.AuthorUser,
+		AuthorID:    "550e8400-e29b-41d4-a716-44665544000c",
+	})
+	require.NoError(t, err)
+
+	_, err = c.SendFeedback(ctx, published.ID, "Good offer", "user4")
+	require.NoError(t, err)
+
+	variables := map[string]any{"id": tender.ID}
+
+	// Responsible of the tender organization sees published bids, their reviews and the whole organization.
+	res := s.graphqlQuery(t, "user4", tenderPageQuery, variables)
+	require.Empty(t, res.Errors)
+
+	var page tenderPage
+	require.NoError(t, json.Unmarshal(res.Data, &page))
+	require.NotNil(t, page.Tender)
+	require.Equal(t, "GraphQL tender", page.Tender.Name)
+	require.Equal(t, "Organization 2", page.Tender.Organization.Name)
+	require.Len(t, page.Tender.Organization.Responsibles, 3)
+	require.Len(t, page.Tender.History, 1)
+	require.Equal(t, 1, page.Tender.History[0].Version)
+	require.Len(t, page.Tender.Bids, 1)
+	require.Equal(t, "Published bid", page.Tender.Bids[0].Name)
+	require.Equal(t, "user12", page.Tender.Bids[0].Author.Username)
+	require.NotNil(t, page.Tender.Bids[0].Author.Organization)
+	require.Equal(t, "Organization 4", page.Tender.Bids[0].Author.Organization.Name)
+	require.Len(t, page.Tender.Bids[0].Reviews, 1)
+	require.Equal(t, "Good offer", page.Tender.Bids[0].Reviews[0].Description)
+
+	// Bid author sees all their bids, but neither reviews nor the tender organization internals.
+	res = s.graphqlQuery(t, "user12", tenderPageQuery, variables)
+	page = tenderPage{}
+	require.NoError(t, json.Unmarshal(res.Data, &page))
+	require.NotNil(t, page.Tender)
+	require.Len(t, page.Tender.Bids, 2)
+	require.Nil(t, page.Tender.History)
+	require.Nil(t, page.Tender.Organization.Responsibles)
+	for _, bid := range page.Tender.Bids {
+		require.Nil(t, bid.Reviews)
+	}
+	for _, queryErr := range res.Errors {
+		require.Equal(t, apperror.CodeForbidden, queryErr.Extensions.Code)
+		require.Equal(t, http.StatusForbidden, queryErr.Extensions.Status)
+	}
+	require.Len(t, res.Errors, 4)
+
+	// Anonymous requester sees the published tender only.
+	res = s.graphqlQuery(t, "", `query($id: ID!) { tender(id: $id) { name bids { name } } viewer { username } }`, variables)
+	require.Empty(t, res.Errors)
+	require.JSONEq(t, `{"tender": {"name": "GraphQL tender", "bids": []}, "viewer": null}`, string(res.Data))
+
+	// Unpublished tender is available to the organization only.
+	_, err = c.UpdateTenderStatus(ctx, tender.ID, entity.TenderClosed, "user4")
+	require.NoError(t, err)
+
+	res = s.graphqlQuery(t, "", `query($id: ID!) { tender(id: $id) { name } }`, variables)
+	require.Len(t, res.Errors, 1)
+	require.Equal(t, apperror.CodeUserRequired, res.Errors[0].Extensions.Code)
+	require.JSONEq(t, `{"tender": null}`, string(res.Data))
+
+	res = s.graphqlQuery(t, "user12", `query($id: ID!) { tender(id: $id) { name } }`, variables)
+	require.Len(t, res.Errors, 1)
+	require.Equal(t, apperror.CodeForbidden, res.Errors[0].Extensions.Code)
+
+	res = s.graphqlQuery(t, "user4", `{ myTenders(limit: 50) { id } viewer { username organization { name } } }`, nil)
+	require.Empty(t, res.Errors)
+	require.Contains(t, string(res.Data), tender.ID)
+	require.Contains(t, string(res.Data), `"organization":{"name":"Organization 2"}`)
+}
+
+func (s *TestSuite) TestGraphQLHidesDisputedReviews() {
+	t := s.T()
+	ctx := context.Background()
+	c := s.newClient()
+
+	tender := s.createTender(t, c, "graphql/create_disputed_tender.json")
+	bid := s.createPublishedBid(t, c, "graphql/create_disputed_bid.json", "user12", map[string]interface{}{
+		"tenderId": tender.ID,
+	})
+
+	_, err := c.SendFeedback(ctx, bid.ID, "Unfair review", "user4")
+	require.NoError(t, err)
+
+	reviews, err := c.Reviews(ctx, tender.ID, "user12", "user4", queryparams.Pagination{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+
+	query := `query($id: ID!) { tender(id: $id) { bids { reviews { description } } } }`
+	variables := map[string]any{"id": tender.ID}
+
+	res := s.graphqlQuery(t, "user4", query, variables)
+	require.Empty(t, res.Errors)
+	require.JSONEq(t, `{"tender": {"bids": [{"reviews": [{"description": "Unfair review"}]}]}}`, string(res.Data))
+
+	// Disputed review is hidden pending resolution.
+	_, err = c.DisputeReview(ctx, reviews[0].ID, "The review is false", "user12")
+	require.NoError(t, err)
+
+	res = s.graphqlQuery(t, "user4", query, variables)
+	require.Empty(t, res.Errors)
+	require.JSONEq(t, `{"tender": {"bids": [{"reviews": []}]}}`, string(res.Data))
+
+	// Review is shown again when arbitration rejects the dispute.
+	_, err = c.ArbitrateReview(ctx, reviews[0].ID, entity.DisputeRejected, "", "user4")
+	require.NoError(t, err)
+
+	res = s.graphqlQuery(t, "user4", query, variables)
+	require.Empty(t, res.Errors)
+	require.JSONEq(t, `{"tender": {"bids": [{"reviews": [{"description": "Unfair review"}]}]}}`, string(res.Data))
+}
+
+func (s *TestSuite) TestGraphQLErrors() {
+	t := s.T()
+
+	// Errors of arguments are the same as in REST API.
+	res := s.graphqlQuery(t, "user4", `{ tenders(limit: 100) { id } }`, nil)
+	require.Len(t, res.Errors, 1)
+	require.Equal(t, apperror.CodeInvalidInput, res.Errors[0].Extensions.Code)
+
+	res = s.graphqlQuery(t, "user4", `{ tender(id: "not-an-id") { id } }`, nil)
+	require.Len(t, res.Errors, 1)
+	require.Equal(t, apperror.CodeInvalidInput, res.Errors[0].Extensions.Code)
+
+	res = s.graphqlQuery(t, "user4", `{ tender(id: "550e8400-e29b-41d4-a716-446655449999") { id } }`, nil)
+	require.Len(t, res.Errors, 1)
+	require.Equal(t, apperror.CodeNotFound, res.Errors[0].Extensions.Code)
+
+	// Invalid queries are reported by GraphQL.
+	res = s.graphqlQuery(t, "user4", `{ tenders { unknown } }`, nil)
+	require.Len(t, res.Errors, 1)
+	require.Empty(t, res.Data)
+
+	// Username is optional, but must exist when given.
+	s.doRequest(t, http.MethodPost, "/api/graphql", url.Values{"username": {"unknown"}},
+		bytes.NewReader([]byte(`{"query": "{ viewer { username } }"}`)), http.StatusUnauthorized)
+
+	s.doRequest(t, http.MethodPost, "/api/graphql", nil, bytes.NewReader([]byte(`{}`)), http.StatusBadRequest)
+}
+
+func TestGraphQLSchemaBindsResolvers(t *testing.T) {
+	_, err := graphql.NewSchema(graphql.Opts{})
+	require.NoError(t, err)
+}
+
+func TestLoaderBatchesConcurrentLoads(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		batches [][]int
+	)
+
+	loader := dataloader.New(func(_ context.Context, keys []int) (map[int]string, error) {
+		mu.Lock()
+		batches = append(batches, keys)
+		mu.Unlock()
+
+		values := make(map[int]string, len(keys))
+		for _, key := range keys {
+			if key%2 == 0 {
+				values[key] = fmt.Sprint(key)
+			}
+		}
+
+		return values, nil
+	}, dataloader.Wait(time.Second), dataloader.MaxBatch(5))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(key int) {
+			defer wg.Done()
+
+			value, found, err := loader.Load(context.Background(), key%5)
+			require.NoError(t, err)
+			require.Equal(t, key%5%2 == 0, found)
+			if found {
+				require.Equal(t, fmt.Sprint(key%5), value)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// Repeated keys are loaded once, the batch is fetched as soon as it is full, cached keys are not fetched again.
+	require.Len(t, batches, 1)
+	require.ElementsMatch(t, []int{0, 1, 2, 3, 4}, batches[0])
+
+	loader.Prime(6, "primed")
+	values, err := loader.LoadMany(context.Background(), []int{0, 1, 6})
+	require.NoError(t, err)
+	require.Equal(t, []string{"0", "primed"}, values)
+	require.Len(t, batches, 1)
+}
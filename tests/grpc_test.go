@@ -0,0 +1,264 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"avito-tenders/internal/api"
+	"avito-tenders/pkg/apperror"
+	tendersv1 "avito-tenders/pkg/pb/tenders/v1"
+)
+
+const grpcBufferSize = 1024 * 1024
+
+// startGRPC serves gRPC API of the suite backend over in-memory connection.
+func (s *TestSuite) startGRPC() {
+	listener := bufconn.Listen(grpcBufferSize)
+
+	s.grpcServer = api.InitGRPCServer(s.back)
+	go func() {
+		if err := s.grpcServer.Serve(listener); err != nil {
+			s.T().Logf("gRPC server stopped: %v", err)
+		}
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	s.Require().NoError(err)
+
+	s.grpcConn = conn
+}
+
+func (s *TestSuite) stopGRPC() {
+	s.Require().NoError(s.grpcConn.Close())
+	s.grpcServer.Stop()
+}
+
+// asUser returns context with username metadata.
+func asUser(username string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "username", username)
+}
+
+// requireGRPCError checks gRPC status code and error code from ErrorInfo details.
+func requireGRPCError(t require.TestingT, err error, code codes.Code, errorCode string) *status.Status {
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, code, st.Code())
+
+	var reason string
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			reason = info.GetReason()
+		}
+	}
+	require.Equal(t, errorCode, reason)
+
+	return st
+}
+
+func (s *TestSuite) TestGRPCTenders() {
+	t := s.T()
+	tendersClient := tendersv1.NewTenderServiceClient(s.grpcConn)
+
+	tender, err := tendersClient.CreateTender(context.Background(), &tendersv1.CreateTenderRequest{
+		Name:            "gRPC tender",
+		Description:     "Created over gRPC",
+		ServiceType:     tendersv1.ServiceType_SERVICE_TYPE_DELIVERY,
+		Status:          tendersv1.TenderStatus_TENDER_STATUS_CREATED,
+		OrganizationId:  "550e8400-e29b-41d4-a716-446655440021",
+		CreatorUsername: "user4",
+	})
+	require.NoError(t, err)
+	require.Equal(t, tendersv1.TenderStatus_TENDER_STATUS_CREATED, tender.GetStatus())
+	require.EqualValues(t, 1, tender.GetVersion())
+
+	tender, err = tendersClient.UpdateTenderStatus(asUser("user4"), &tendersv1.UpdateTenderStatusRequest{
+		TenderId: tender.GetId(),
+		Status:   tendersv1.TenderStatus_TENDER_STATUS_PUBLISHED,
+	})
+	require.NoError(t, err)
+
+	// Published tender status is public.
+	status, err := tendersClient.GetTenderStatus(context.Background(), &tendersv1.GetTenderStatusRequest{TenderId: tender.GetId()})
+	require.NoError(t, err)
+	require.Equal(t, tendersv1.TenderStatus_TENDER_STATUS_PUBLISHED, status.GetStatus())
+
+	name := "gRPC tender edited"
+	tender, err = tendersClient.EditTender(asUser("user4"), &tendersv1.EditTenderRequest{TenderId: tender.GetId(), Name: &name})
+	require.NoError(t, err)
+	require.Equal(t, name, tender.GetName())
+	require.Equal(t, "Created over gRPC", tender.GetDescription())
+
+	tender, err = tendersClient.RollbackTender(asUser("user4"), &tendersv1.RollbackTenderRequest{TenderId: tender.GetId(), Version: 2})
+	require.NoError(t, err)
+	require.Equal(t, "gRPC tender", tender.GetName())
+
+	limit := int32(50)
+	myTenders, err := tendersClient.ListMyTenders(asUser("user4"), &tendersv1.ListMyTendersRequest{Limit: &limit})
+	require.NoError(t, err)
+	require.Contains(t, tenderIDs(myTenders.GetTenders()), tender.GetId())
+
+	published, err := tendersClient.ListTenders(context.Background(), &tendersv1.ListTendersRequest{
+		ServiceTypes: []tendersv1.ServiceType{tendersv1.ServiceType_SERVICE_TYPE_DELIVERY},
+		Limit:        &limit,
+	})
+	require.NoError(t, err)
+	require.Contains(t, tenderIDs(published.GetTenders()), tender.GetId())
+	for _, publishedTender := range published.GetTenders() {
+		require.Equal(t, tendersv1.ServiceType_SERVICE_TYPE_DELIVERY, publishedTender.GetServiceType())
+	}
+}
+
+func (s *TestSuite) TestGRPCBidsAndReviews() {
+	t := s.T()
+	tendersClient := tendersv1.NewTenderServiceClient(s.grpcConn)
+	bidsClient := tendersv1.NewBidServiceClient(s.grpcConn)
+	reviewsClient := tendersv1.NewReviewServiceClient(s.grpcConn)
+
+	tender, err := tendersClient.CreateTender(context.Background(), &tendersv1.CreateTenderRequest{
+		Name:            "gRPC tender",
+		Description:     "Created over gRPC",
+		ServiceType:     tendersv1.ServiceType_SERVICE_TYPE_CONSTRUCTION,
+		Status:          tendersv1.TenderStatus_TENDER_STATUS_PUBLISHED,
+		OrganizationId:  "550e8400-e29b-41d4-a716-446655440021",
+		CreatorUsername: "user4",
+	})
+	require.NoError(t, err)
+
+	bid, err := bidsClient.CreateBid(context.Background(), &tendersv1.CreateBidRequest{
+		Name:        "gRPC bid",
+		Description: "Created over gRPC",
+		TenderId:    tender.GetId(),
+		AuthorType:  tendersv1.AuthorType_AUTHOR_TYPE_USER,
+		AuthorId:    "550e8400-e29b-41d4-a716-44665544000c",
+	})
+	require.NoError(t, err)
+	require.Equal(t, tendersv1.BidStatus_BID_STATUS_CREATED, bid.GetStatus())
+
+	bid, err = bidsClient.UpdateBidStatus(asUser("user12"), &tendersv1.UpdateBidStatusRequest{
+		BidId:  bid.GetId(),
+		Status: tendersv1.BidStatus_BID_STATUS_PUBLISHED,
+	})
+	require.NoError(t, err)
+
+	bidStatus, err := bidsClient.GetBidStatus(asUser("user12"), &tendersv1.GetBidStatusRequest{BidId: bid.GetId()})
+	require.NoError(t, err)
+	require.Equal(t, tendersv1.BidStatus_BID_STATUS_PUBLISHED, bidStatus.GetStatus())
+
+	name := "gRPC bid edited"
+	bid, err = bidsClient.EditBid(asUser("user12"), &tendersv1.EditBidRequest{BidId: bid.GetId(), Name: &name})
+	require.NoError(t, err)
+	require.Equal(t, name, bid.GetName())
+
+	bid, err = bidsClient.RollbackBid(asUser("user12"), &tendersv1.RollbackBidRequest{BidId: bid.GetId(), Version: 2})
+	require.NoError(t, err)
+	require.Equal(t, "gRPC bid", bid.GetName())
+
+	myBids, err := bidsClient.ListMyBids(asUser("user12"), &tendersv1.ListMyBidsRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, myBids.GetBids())
+
+	tenderBids, err := bidsClient.ListTenderBids(asUser("user4"), &tendersv1.ListTenderBidsRequest{TenderId: tender.GetId()})
+	require.NoError(t, err)
+	require.Len(t, tenderBids.GetBids(), 1)
+
+	_, err = reviewsClient.SendFeedback(asUser("user4"), &tendersv1.SendFeedbackRequest{BidId: bid.GetId(), Feedback: "Good offer"})
+	require.NoError(t, err)
+
+	reviews, err := reviewsClient.ListReviews(asUser("user4"), &tendersv1.ListReviewsRequest{
+		TenderId:       tender.GetId(),
+		AuthorUsername: "user12",
+	})
+	require.NoError(t, err)
+	require.Len(t, reviews.GetReviews(), 1)
+	require.Equal(t, "Good offer", reviews.GetReviews()[0].GetDescription())
+
+	for _, username := range []string{"user4", "user5", "user6"} {
+		bid, err = bidsClient.SubmitDecision(asUser(username), &tendersv1.SubmitDecisionRequest{
+			BidId:    bid.GetId(),
+			Decision: tendersv1.BidDecision_BID_DECISION_APPROVED,
+		})
+		require.NoError(t, err)
+	}
+	require.Equal(t, tendersv1.BidStatus_BID_STATUS_APPROVED, bid.GetStatus())
+
+	_, err = bidsClient.EditBid(asUser("user4"), &tendersv1.EditBidRequest{BidId: bid.GetId(), Name: &name})
+	requireGRPCError(t, err, codes.PermissionDenied, apperror.CodeForbidden)
+}
+
+func (s *TestSuite) TestGRPCErrors() {
+	t := s.T()
+	tendersClient := tendersv1.NewTenderServiceClient(s.grpcConn)
+
+	// Username is required like in HTTP API.
+	_, err := tendersClient.ListMyTenders(context.Background(), &tendersv1.ListMyTendersRequest{})
+	requireGRPCError(t, err, codes.Unauthenticated, apperror.CodeUserRequired)
+
+	_, err = tendersClient.ListMyTenders(asUser("unknown"), &tendersv1.ListMyTendersRequest{})
+	requireGRPCError(t, err, codes.Unauthenticated, apperror.CodeUserDoesNotExist)
+
+	// Validation errors list violated fields.
+	_, err = tendersClient.CreateTender(context.Background(), &tendersv1.CreateTenderRequest{Name: "No fields"})
+	st := requireGRPCError(t, err, codes.InvalidArgument, apperror.CodeValidationFailed)
+
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields = append(fields, violation.GetField())
+			}
+		}
+	}
+	require.Equal(t, []string{"creatorUsername", "description", "organizationId", "serviceType", "status"}, fields)
+
+	limit := int32(100)
+	_, err = tendersClient.ListTenders(context.Background(), &tendersv1.ListTendersRequest{Limit: &limit})
+	requireGRPCError(t, err, codes.InvalidArgument, apperror.CodeInvalidInput)
+
+	_, err = tendersClient.GetTenderStatus(asUser("user4"), &tendersv1.GetTenderStatusRequest{TenderId: "550e8400-e29b-41d4-a716-446655449999"})
+	requireGRPCError(t, err, codes.NotFound, apperror.CodeNotFound)
+
+	// Messages are translated by accept-language metadata.
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "accept-language", "ru")
+	var header metadata.MD
+	_, err = tendersClient.ListMyTenders(ctx, &tendersv1.ListMyTendersRequest{}, grpc.Header(&header))
+	st = requireGRPCError(t, err, codes.Unauthenticated, apperror.CodeUserRequired)
+	require.Equal(t, []string{"ru"}, header.Get("content-language"))
+	require.NotEqual(t, apperror.ErrUserEmpty.Error(), st.Message())
+}
+
+func tenderIDs(tenders []*tendersv1.Tender) []string {
+	ids := make([]string, 0, len(tenders))
+	for _, tender := range tenders {
+		ids = append(ids, tender.GetId())
+	}
+
+	return ids
+}
+
+func TestGRPCCodesCoverCatalogue(t *testing.T) {
+	for _, entry := range apperror.Catalogue {
+		code := apperror.GRPCCode(entry.Code)
+		if entry.Status >= http.StatusInternalServerError {
+			require.Equal(t, codes.Internal, code, entry.Code)
+			continue
+		}
+
+		require.NotEqual(t, codes.Internal, code, "error code %s is not mapped to gRPC code", entry.Code)
+	}
+}
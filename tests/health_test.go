@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"avito-tenders/internal/api"
+	"avito-tenders/pkg/health"
+	"avito-tenders/pkg/httpserver"
+)
+
+func (s *TestSuite) TestHealthProbes() {
+	t := s.T()
+
+	res := s.doRequest(t, http.MethodGet, "/healthz", nil, nil, http.StatusOK)
+	require.Equal(t, health.StatusOK, decodeResponse[health.Report](t, res).Status)
+
+	res = s.doRequest(t, http.MethodGet, "/readyz", nil, nil, http.StatusOK)
+	require.Equal(t, health.StatusOK, decodeResponse[health.Report](t, res).Status)
+
+	res = s.doRequest(t, http.MethodGet, "/health", nil, nil, http.StatusOK)
+	report := decodeResponse[health.Report](t, res)
+	require.Equal(t, health.StatusOK, report.Status)
+	require.False(t, report.Draining)
+
+	names := make([]string, 0, len(report.Components))
+	for _, c := range report.Components {
+		require.Equal(t, health.StatusOK, c.Status, c.Error)
+		require.GreaterOrEqual(t, c.LatencyMs, 0.0)
+		names = append(names, c.Name)
+	}
+	require.Equal(t, []string{"database", "migrations"}, names)
+}
+
+func (s *TestSuite) TestReadinessFailsWhileDraining() {
+	t := s.T()
+
+	back := s.back
+	back.Health = health.NewChecker()
+	back.Health.Register("database", health.DBCheck(back.DB.DB))
+
+	routes, err := api.InitAPIRoutes(back)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	server := httpserver.New(routes,
+		httpserver.Address(addr),
+		httpserver.OnShutdown(back.Health.StartDraining),
+		httpserver.DrainDelay(2*time.Second),
+	)
+
+	readyz := fmt.Sprintf("http://%s/readyz", addr)
+	require.Eventually(t, func() bool {
+		res, err := http.Get(readyz)
+		if err != nil {
+			return false
+		}
+		defer res.Body.Close()
+
+		return res.StatusCode == http.StatusOK
+	}, 5*time.Second, 50*time.Millisecond)
+
+	shutdown := make(chan error, 1)
+	go func() { shutdown <- server.Shutdown() }()
+
+	// Server still serves requests during drain, but readiness fails.
+	require.Eventually(t, func() bool {
+		res, err := http.Get(readyz)
+		if err != nil {
+			return false
+		}
+		defer res.Body.Close()
+
+		return res.StatusCode == http.StatusServiceUnavailable
+	}, time.Second, 50*time.Millisecond)
+
+	res, err := http.Get(fmt.Sprintf("http://%s/healthz", addr))
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	require.NoError(t, <-shutdown)
+	require.Eventually(t, func() bool { return !server.Running() }, time.Second, 10*time.Millisecond)
+}
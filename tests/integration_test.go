@@ -11,15 +11,20 @@ import (
 
 	"github.com/go-testfixtures/testfixtures/v3"
 	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
 
 	"avito-tenders/config"
 	"avito-tenders/internal/api"
+	"avito-tenders/internal/app"
+	"avito-tenders/migrations"
 	"avito-tenders/pkg/backend"
+	"avito-tenders/pkg/health"
+	"avito-tenders/pkg/tracing"
 
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
 )
 
 const (
@@ -32,6 +37,9 @@ type TestSuite struct {
 	server        *httptest.Server
 	loader        *FixtureLoader
 	back          backend.Backend
+	violations    specViolations
+	grpcServer    *grpc.Server
+	grpcConn      *grpc.ClientConn
 }
 
 func (s *TestSuite) SetupSuite() {
@@ -47,7 +55,10 @@ func (s *TestSuite) SetupSuite() {
 	// run migrations
 	var m *migrate.Migrate
 	for i := 0; i < 20; i++ {
-		m, err = migrate.New("file://../migrations", psqlContainer.GetDSN())
+		src, srcErr := iofs.New(migrations.FS, ".")
+		s.Require().NoError(srcErr)
+
+		m, err = migrate.NewWithSourceInstance("iofs", src, psqlContainer.GetDSN())
 		if err == nil {
 			break
 		}
@@ -70,6 +81,10 @@ func (s *TestSuite) SetupSuite() {
 
 	log.Printf("Migrate: up success")
 
+	// set trace context propagator
+	_, err = tracing.Setup(ctx, tracing.Opts{Exporter: tracing.ExporterNone})
+	s.Require().NoError(err)
+
 	// mock client
 	mockClient := &http.Client{}
 	httpmock.ActivateNonDefault(mockClient)
@@ -83,13 +98,24 @@ func (s *TestSuite) SetupSuite() {
 		PostgresHost:     psqlContainer.Config.Host,
 		PostgresPort:     psqlContainer.Config.MappedPort,
 		PostgresDatabase: psqlContainer.Config.Database,
+		StorageDriver:    "local",
+		StorageLocalPath: s.T().TempDir(),
+		// Small limit to check that large attachments are rejected.
+		AttachmentMaxSize: 1024,
 	})
 	if err != nil {
 		log.Panicf("Failed to initialize backend: %v", err)
 	}
 
+	// Every response of the suite is checked against the OpenAPI spec, see TearDownTest.
+	back.SpecViolations = s.violations.report
+
 	s.back = back
 
+	expectedVersion, err := app.LatestMigrationVersion()
+	s.Require().NoError(err)
+	s.back.Health.Register("migrations", health.MigrationsCheck(s.back.DB.DB, expectedVersion))
+
 	// insert default data
 	queriesLoader := NewQueriesLoader(s.T(), Queries)
 	query := queriesLoader.LoadString("queries/default_data.sql")
@@ -103,6 +129,7 @@ func (s *TestSuite) SetupSuite() {
 
 	// use httptest
 	s.server = httptest.NewServer(routes)
+	s.startGRPC()
 
 	// create fixture loader
 	s.loader = NewFixtureLoader(s.T(), Fixtures)
@@ -113,6 +140,7 @@ func (s *TestSuite) TearDownSuite() {
 	defer ctxCancel()
 
 	s.server.Close()
+	s.stopGRPC()
 
 	httpmock.DeactivateAndReset()
 
@@ -128,6 +156,13 @@ func (s *TestSuite) SetupTest() {
 	)
 	s.Require().NoError(err)
 	s.Require().NoError(fixtures.Load())
+
+	s.violations.take()
+}
+
+// check that handlers conform to the OpenAPI spec after each test.
+func (s *TestSuite) TearDownTest() {
+	s.Empty(s.violations.take(), "handlers diverge from the OpenAPI spec")
 }
 
 func TestSuite_Run(t *testing.T) {
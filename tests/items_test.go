@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	bidsDtos "avito-tenders/internal/api/bids/dtos"
+	"avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/internal/entity"
+)
+
+func (s *TestSuite) TestLineItems() {
+	t := s.T()
+
+	res := s.doRequest(t, http.MethodPost, "/api/tenders/new", nil, s.loadBody("items/create_tender.json"), http.StatusOK)
+	tender := decodeResponse[dtos.TenderResponse](t, res)
+
+	itemsPath := fmt.Sprintf("/api/tenders/%s/items", tender.ID)
+
+	res = s.doRequest(t, http.MethodPost, itemsPath+"/new", userQuery("user4"), s.loadBody("items/create_item_bricks.json"), http.StatusOK)
+	bricks := decodeResponse[dtos.ItemResponse](t, res)
+
+	res = s.doRequest(t, http.MethodPost, itemsPath+"/new", userQuery("user5"), s.loadBody("items/create_item_cement.json"), http.StatusOK)
+	cement := decodeResponse[dtos.ItemResponse](t, res)
+
+	// Items of not published tender are hidden from other organizations.
+	s.doRequest(t, http.MethodGet, itemsPath, userQuery("user1"), nil, http.StatusForbidden)
+	s.doRequest(t, http.MethodGet, itemsPath, nil, nil, http.StatusUnauthorized)
+
+	// Bill of quantities is frozen after publication.
+	s.doRequest(t, http.MethodPut, fmt.Sprintf("/api/tenders/%s/status", tender.ID), url.Values{
+		"username": []string{"user4"},
+		"status":   []string{string(entity.TenderPublished)},
+	}, nil, http.StatusOK)
+	s.doRequest(t, http.MethodPost, itemsPath+"/new", userQuery("user4"), s.loadBody("items/create_item_bricks.json"), http.StatusForbidden)
+
+	res = s.doRequest(t, http.MethodGet, itemsPath, nil, nil, http.StatusOK)
+	require.Len(t, decodeResponse[[]dtos.ItemResponse](t, res), 2)
+
+	res = s.doRequest(t, http.MethodPost, "/api/bids/new", nil, s.loadTemplateBody("items/create_bid.json", map[string]interface{}{
+		"tenderId": tender.ID,
+	}), http.StatusOK)
+	bid := decodeResponse[bidsDtos.BidResponse](t, res)
+
+	bidItemsPath := fmt.Sprintf("/api/bids/%s/items", bid.ID)
+
+	// Every item must be quoted.
+	s.doRequest(t, http.MethodPut, bidItemsPath, userQuery("user12"), s.loadTemplateBody("items/quotes.json", map[string]interface{}{
+		"bricksId": bricks.ID,
+	}), http.StatusBadRequest)
+
+	// Only author can quote.
+	s.doRequest(t, http.MethodPut, bidItemsPath, userQuery("user4"), s.loadTemplateBody("items/quotes.json", map[string]interface{}{
+		"bricksId": bricks.ID,
+		"cementId": cement.ID,
+	}), http.StatusForbidden)
+
+	res = s.doRequest(t, http.MethodPut, bidItemsPath, userQuery("user12"), s.loadTemplateBody("items/quotes.json", map[string]interface{}{
+		"bricksId": bricks.ID,
+		"cementId": cement.ID,
+	}), http.StatusOK)
+	bidItems := decodeResponse[bidsDtos.BidItemsResponse](t, res)
+	require.Len(t, bidItems.Items, 2)
+	require.True(t, decimal.RequireFromString("14504").Equal(bidItems.Total))
+
+	s.doRequest(t, http.MethodPut, fmt.Sprintf("/api/bids/%s/status", bid.ID), url.Values{
+		"username": []string{"user12"},
+		"status":   []string{string(entity.BidPublished)},
+	}, nil, http.StatusOK)
+
+	comparisonPath := fmt.Sprintf("/api/bids/%s/comparison", tender.ID)
+	s.doRequest(t, http.MethodGet, comparisonPath, userQuery("user12"), nil, http.StatusForbidden)
+
+	res = s.doRequest(t, http.MethodGet, comparisonPath, userQuery("user4"), nil, http.StatusOK)
+	comparison := decodeResponse[bidsDtos.ItemsComparisonResponse](t, res)
+	require.Len(t, comparison.Items, 2)
+	require.Len(t, comparison.Bids, 1)
+	require.Equal(t, bid.ID, comparison.Bids[0].BidID)
+	require.True(t, decimal.RequireFromString("14504").Equal(comparison.Bids[0].Total))
+	require.Len(t, comparison.Items[0].Quotes, 1)
+}
@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/stretchr/testify/require"
+)
+
+func (s *TestSuite) TestAccessLog() {
+	t := s.T()
+
+	buf := &bytes.Buffer{}
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(buf, nil)))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	res := s.doRequest(t, http.MethodGet, "/api/tenders/my", url.Values{"username": []string{"user4"}}, nil, http.StatusOK)
+	requestID := res.Header.Get("X-Request-Id")
+
+	var entry map[string]any
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+
+		if line["msg"] == "request handled" {
+			entry = line
+		}
+	}
+	require.NotNil(t, entry, "access log entry is not found")
+
+	require.Equal(t, requestID, entry["requestId"])
+	require.Equal(t, "/api/tenders/my", entry["route"])
+	require.Equal(t, "user4", entry["username"])
+	require.EqualValues(t, http.StatusOK, entry["status"])
+	require.Contains(t, entry, "latencyMs")
+}
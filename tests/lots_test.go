@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/stretchr/testify/require"
+
+	bidsDtos "avito-tenders/internal/api/bids/dtos"
+	"avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/internal/entity"
+)
+
+func (s *TestSuite) TestTenderLots() {
+	t := s.T()
+
+	// Create published tender.
+	res := s.doRequest(t, http.MethodPost, "/api/tenders/new", nil, s.loadBody("lots/create_tender.json"), http.StatusOK)
+	tender := decodeResponse[dtos.TenderResponse](t, res)
+
+	lotsPath := fmt.Sprintf("/api/tenders/%s/lots", tender.ID)
+
+	// Only responsible users can create lots.
+	s.doRequest(t, http.MethodPost, lotsPath+"/new", userQuery("user1"), s.loadBody("lots/create_lot_a.json"), http.StatusForbidden)
+
+	res = s.doRequest(t, http.MethodPost, lotsPath+"/new", userQuery("user4"), s.loadBody("lots/create_lot_a.json"), http.StatusOK)
+	lotA := decodeResponse[dtos.LotResponse](t, res)
+	require.Equal(t, entity.LotOpen, lotA.Status)
+
+	res = s.doRequest(t, http.MethodPost, lotsPath+"/new", userQuery("user5"), s.loadBody("lots/create_lot_b.json"), http.StatusOK)
+	lotB := decodeResponse[dtos.LotResponse](t, res)
+
+	res = s.doRequest(t, http.MethodGet, lotsPath, nil, nil, http.StatusOK)
+	require.Len(t, decodeResponse[[]dtos.LotResponse](t, res), 2)
+
+	// Bids for tender with lots must target lots.
+	bidBody := func(lotID string) io.Reader {
+		return s.loadTemplateBody("lots/create_bid.json", map[string]interface{}{
+			"tenderId": tender.ID,
+			"lotId":    lotID,
+		})
+	}
+	s.doRequest(t, http.MethodPost, "/api/bids/new", nil, bidBody(""), http.StatusBadRequest)
+
+	res = s.doRequest(t, http.MethodPost, "/api/bids/new", nil, bidBody(lotA.ID), http.StatusOK)
+	bid := decodeResponse[bidsDtos.BidResponse](t, res)
+	require.Equal(t, []string{lotA.ID}, bid.LotIDs)
+
+	s.doRequest(t, http.MethodPut, fmt.Sprintf("/api/bids/%s/status", bid.ID), url.Values{
+		"username": []string{"user12"},
+		"status":   []string{string(entity.BidPublished)},
+	}, nil, http.StatusOK)
+
+	// Rollback keeps lots targeted by the version.
+	res = s.doRequest(t, http.MethodPut, fmt.Sprintf("/api/bids/%s/rollback/1", bid.ID), userQuery("user12"), nil, http.StatusOK)
+	rolledBack := decodeResponse[bidsDtos.BidResponse](t, res)
+	require.Equal(t, entity.BidCreated, rolledBack.Status)
+	require.Equal(t, []string{lotA.ID}, rolledBack.LotIDs)
+
+	s.doRequest(t, http.MethodPut, fmt.Sprintf("/api/bids/%s/status", bid.ID), url.Values{
+		"username": []string{"user12"},
+		"status":   []string{string(entity.BidPublished)},
+	}, nil, http.StatusOK)
+
+	// Approval of every responsible awards lot A, but tender stays open for lot B.
+	for _, username := range []string{"user4", "user5", "user6"} {
+		s.doRequest(t, http.MethodPut, fmt.Sprintf("/api/bids/%s/submit_decision", bid.ID), url.Values{
+			"username": []string{username},
+			"decision": []string{string(entity.DecisionApproved)},
+		}, nil, http.StatusOK)
+	}
+
+	res = s.doRequest(t, http.MethodGet, lotsPath, nil, nil, http.StatusOK)
+	lots := decodeResponse[[]dtos.LotResponse](t, res)
+	require.Len(t, lots, 2)
+	require.Equal(t, entity.LotAwarded, lots[0].Status)
+	require.Equal(t, bid.ID, *lots[0].AwardedBidID)
+	require.Equal(t, entity.LotOpen, lots[1].Status)
+
+	statusPath := fmt.Sprintf("/api/tenders/%s/status", tender.ID)
+	res = s.doRequest(t, http.MethodGet, statusPath, userQuery("user4"), nil, http.StatusOK)
+	status, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.Equal(t, string(entity.TenderPublished), string(status))
+
+	// Cancelling the last open lot closes tender.
+	s.doRequest(t, http.MethodPut, fmt.Sprintf("%s/%s/cancel", lotsPath, lotB.ID), userQuery("user4"), nil, http.StatusOK)
+	s.doRequest(t, http.MethodPut, fmt.Sprintf("%s/%s/cancel", lotsPath, lotB.ID), userQuery("user4"), nil, http.StatusForbidden)
+
+	res = s.doRequest(t, http.MethodGet, statusPath, userQuery("user4"), nil, http.StatusOK)
+	status, err = io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.Equal(t, string(entity.TenderClosed), string(status))
+
+	// Lots of closed tender are hidden from anonymous users.
+	s.doRequest(t, http.MethodGet, lotsPath, nil, nil, http.StatusUnauthorized)
+	s.doRequest(t, http.MethodGet, lotsPath, userQuery("user4"), nil, http.StatusOK)
+}
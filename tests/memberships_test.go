@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/require"
+
+	bidsDtos "avito-tenders/internal/api/bids/dtos"
+	tendersDtos "avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/client"
+	"avito-tenders/pkg/queryparams"
+)
+
+func (s *TestSuite) TestBidsOfSeveralOrganizations() {
+	t := s.T()
+	ctx := context.Background()
+	c := s.newClient()
+
+	const (
+		memberID      = "550e8400-e29b-41d4-a716-44665544000d"
+		firstOrgID    = "550e8400-e29b-41d4-a716-446655440022"
+		secondOrgID   = "550e8400-e29b-41d4-a716-446655440023"
+		foreignOrgID  = "550e8400-e29b-41d4-a716-446655440020"
+		memberOfFirst = "user7"
+	)
+
+	// The employee is responsible in two organizations.
+	_, err := s.back.DB.ExecContext(ctx,
+		`insert into employee (id, username, first_name, last_name) values ($1, 'user13', 'First13', 'Last13')`, memberID)
+	require.NoError(t, err)
+
+	_, err = s.back.DB.ExecContext(ctx,
+		`insert into organization_responsible (organization_id, user_id) values ($2, $1), ($3, $1)`,
+		memberID, firstOrgID, secondOrgID)
+	require.NoError(t, err)
+	defer func() {
+		_, err := s.back.DB.ExecContext(ctx, `delete from organization_responsible where user_id = $1`, memberID)
+		require.NoError(t, err)
+	}()
+
+	tender, err := c.CreateTender(ctx, tendersDtos.CreateTenderRequest{
+		Name:            "Tender for several organizations",
+		Description:     "Tender of user4",
+		ServiceType:     entity.ServiceDelivery,
+		OrganizationID:  "550e8400-e29b-41d4-a716-446655440021",
+		CreatorUsername: "user4",
+	})
+	require.NoError(t, err)
+
+	_, err = c.UpdateTenderStatus(ctx, tender.ID, entity.TenderPublished, "user4")
+	require.NoError(t, err)
+
+	createBid := func(authorType entity.AuthorType, organizationID *string) (bidsDtos.BidResponse, error) {
+		return c.CreateBid(ctx, bidsDtos.CreateBidRequest{
+			Name:           "Bid of user13",
+			Description:    "Bid on behalf of one of the organizations",
+			TenderID:       tender.ID,
+			AuthorType:     authorType,
+			AuthorID:       memberID,
+			OrganizationID: organizationID,
+		})
+	}
+
+	// The acting organization must be chosen among organizations of the author.
+	_, err = createBid(entity.AuthorOrganization, nil)
+	require.ErrorIs(t, err, client.ErrInvalidInput)
+
+	foreignOrg := foreignOrgID
+	_, err = createBid(entity.AuthorOrganization, &foreignOrg)
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	secondOrg := secondOrgID
+	_, err = createBid(entity.AuthorUser, &secondOrg)
+	require.ErrorIs(t, err, client.ErrValidationFailed)
+
+	bid, err := createBid(entity.AuthorOrganization, &secondOrg)
+	require.NoError(t, err)
+	require.NotNil(t, bid.OrganizationID)
+	require.Equal(t, secondOrgID, *bid.OrganizationID)
+
+	// Responsibles of the acting organization manage the bid, responsibles of the other organization don't.
+	_, err = c.UpdateBidStatus(ctx, bid.ID, entity.BidPublished, memberOfFirst)
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	bid, err = c.UpdateBidStatus(ctx, bid.ID, entity.BidPublished, "user12")
+	require.NoError(t, err)
+	require.Equal(t, entity.BidPublished, bid.Status)
+	require.Equal(t, secondOrgID, *bid.OrganizationID)
+
+	bids, err := c.TenderBids(ctx, tender.ID, memberOfFirst, queryparams.Pagination{Limit: 10})
+	require.NoError(t, err)
+	require.Empty(t, bids)
+}
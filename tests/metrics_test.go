@@ -0,0 +1,35 @@
+package tests
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/stretchr/testify/require"
+
+	"avito-tenders/pkg/metrics"
+)
+
+func (s *TestSuite) TestMetrics() {
+	t := s.T()
+
+	s.doRequest(t, http.MethodPost, "/api/tenders/new", nil,
+		bytes.NewBufferString(s.loader.LoadString(fixturesPath+"/attachments/create_tender.json")), http.StatusOK)
+
+	// Metrics are not exposed by public API.
+	s.doRequest(t, http.MethodGet, "/metrics", nil, nil, http.StatusNotFound)
+	s.doRequest(t, http.MethodGet, "/api/metrics", nil, nil, http.StatusNotFound)
+
+	recorder := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	body, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+
+	require.Contains(t, string(body), `http_requests_total{method="POST",route="/api/tenders/new",status="200"}`)
+	require.Contains(t, string(body), "tenders_created_total")
+	require.Contains(t, string(body), "tenders_published_total")
+	require.Contains(t, string(body), `db_transaction_duration_seconds_count{result="commit"}`)
+}
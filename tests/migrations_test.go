@@ -0,0 +1,45 @@
+package tests
+
+import (
+	"database/sql"
+
+	"github.com/stretchr/testify/require"
+
+	"avito-tenders/internal/app"
+	"avito-tenders/migrations"
+)
+
+func (s *TestSuite) TestMigrationsStatus() {
+	t := s.T()
+
+	// Migrator closes database, so it gets its own connection.
+	db, err := sql.Open("pgx", s.psqlContainer.GetDSN())
+	require.NoError(t, err)
+
+	migrator, err := app.NewMigrator(db)
+	require.NoError(t, err)
+	defer migrator.Close()
+
+	latest, err := app.LatestMigrationVersion()
+	require.NoError(t, err)
+
+	status, err := migrator.Status()
+	require.NoError(t, err)
+	require.Equal(t, latest, status.Version)
+	require.Equal(t, latest, status.Latest)
+	require.False(t, status.Dirty)
+	require.Empty(t, status.Pending)
+
+	require.NoError(t, migrator.Up())
+}
+
+func (s *TestSuite) TestBaseMigrationIsIdempotent() {
+	t := s.T()
+
+	// Base tables may be created before migrations are applied, so the first migration must not fail on them.
+	query, err := migrations.FS.ReadFile("20240909113647_user-organization.up.sql")
+	require.NoError(t, err)
+
+	_, err = s.back.DB.Exec(string(query))
+	require.NoError(t, err)
+}
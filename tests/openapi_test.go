@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"avito-tenders/internal/api/openapi"
+	"avito-tenders/pkg/specvalidator"
+)
+
+// specViolations collects divergences between handlers and the OpenAPI spec reported during a test.
+type specViolations struct {
+	mu   sync.Mutex
+	errs []string
+}
+
+func (v *specViolations) report(r *http.Request, err error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.errs = append(v.errs, fmt.Sprintf("%s %s: %s", r.Method, r.URL, err))
+}
+
+// take returns collected violations and resets them.
+func (v *specViolations) take() []string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	errs := v.errs
+	v.errs = nil
+
+	return errs
+}
+
+func (s *TestSuite) TestOpenAPISpec() {
+	t := s.T()
+
+	res := s.doRequest(t, http.MethodGet, "/api/openapi.yml", nil, nil, http.StatusOK)
+	require.Equal(t, "application/yaml", res.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.Equal(t, openapi.Spec, body)
+
+	res = s.doRequest(t, http.MethodGet, "/api/docs", nil, nil, http.StatusOK)
+	require.Equal(t, "text/html; charset=utf-8", res.Header.Get("Content-Type"))
+
+	body, err = io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), `"/api/openapi.yml"`)
+}
+
+func TestSpecValidatorReportsDivergence(t *testing.T) {
+	doc, err := openapi.Load(context.Background())
+	require.NoError(t, err)
+
+	var violations specViolations
+	validator, err := specvalidator.New(doc, violations.report)
+	require.NoError(t, err)
+
+	writeJSON := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(body))
+			require.NoError(t, err)
+		}
+	}
+
+	r := chi.NewRouter()
+	r.Use(validator.Middleware)
+	r.Route("/api", func(r chi.Router) {
+		r.Get("/tenders/{tenderId}/status", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte("Published"))
+			require.NoError(t, err)
+		})
+		r.Get("/bids/{bidId}/status", writeJSON(`"Published"`))
+		r.Post("/tenders/new", writeJSON(`{"id": "550e8400-e29b-41d4-a716-446655440041"}`))
+		r.Get("/unknown", writeJSON(`{}`))
+	})
+
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	send := func(method, path, body string) {
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		res, err := server.Client().Do(req)
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+	}
+
+	// Conforming handler is not reported.
+	send(http.MethodGet, "/api/tenders/550e8400-e29b-41d4-a716-446655440041/status", "")
+	require.Empty(t, violations.take())
+
+	// Undocumented content type.
+	send(http.MethodGet, "/api/bids/550e8400-e29b-41d4-a716-446655440041/status?username=user1", "")
+	errs := violations.take()
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0], "response of GET /api/bids/{bidId}/status violates the spec")
+
+	// Invalid request is accepted and the response misses required fields.
+	send(http.MethodPost, "/api/tenders/new", `{"name": "Tender"}`)
+	errs = violations.take()
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0], "request to POST /api/tenders/new violates the spec but is accepted with status 200")
+	require.Contains(t, errs[0], "response of POST /api/tenders/new violates the spec")
+
+	// Body without content type is validated as JSON, as handlers decode it.
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/api/tenders/new", strings.NewReader(`{"name": "Tender"}`))
+	require.NoError(t, err)
+	res, err := server.Client().Do(req)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+
+	errs = violations.take()
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0], "request to POST /api/tenders/new violates the spec but is accepted with status 200")
+	require.NotContains(t, errs[0], "Content-Type")
+
+	// Undocumented route.
+	send(http.MethodGet, "/api/unknown", "")
+	errs = violations.take()
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0], "route /api/unknown is not documented")
+}
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// doRequest sends request to the test server and returns response with status code checked.
+func (s *TestSuite) doRequest(t *testing.T, method, path string, query url.Values, body io.Reader, wantStatus int) *http.Response {
+	reqURL := fmt.Sprintf("%s%s", s.server.URL, path)
+	if len(query) != 0 {
+		reqURL = fmt.Sprintf("%s?%s", reqURL, query.Encode())
+	}
+
+	req, err := http.NewRequest(method, reqURL, body)
+	require.NoError(t, err)
+
+	res, err := s.server.Client().Do(req)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { res.Body.Close() })
+
+	require.Equal(t, wantStatus, res.StatusCode)
+
+	return res
+}
+
+// decodeResponse decodes JSON body of the response into value of type T.
+func decodeResponse[T any](t *testing.T, res *http.Response) T {
+	var v T
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&v))
+
+	return v
+}
+
+// uploadFile sends multipart request with the file to the test server and returns response with status code checked.
+func (s *TestSuite) uploadFile(t *testing.T, path string, query url.Values, fileName string, content []byte, wantStatus int) *http.Response {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("file", fileName)
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	reqURL := fmt.Sprintf("%s%s?%s", s.server.URL, path, query.Encode())
+	req, err := http.NewRequest(http.MethodPost, reqURL, body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	res, err := s.server.Client().Do(req)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { res.Body.Close() })
+
+	require.Equal(t, wantStatus, res.StatusCode)
+
+	return res
+}
@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/require"
+
+	bidsDtos "avito-tenders/internal/api/bids/dtos"
+	tendersDtos "avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/client"
+	"avito-tenders/pkg/queryparams"
+)
+
+func (s *TestSuite) TestReviewDisputes() {
+	t := s.T()
+	ctx := context.Background()
+	c := s.newClient()
+
+	const authorID = "550e8400-e29b-41d4-a716-446655440003"
+
+	tender, err := c.CreateTender(ctx, tendersDtos.CreateTenderRequest{
+		Name:            "Disputed tender",
+		Description:     "Reviews of the tender are disputed",
+		ServiceType:     entity.ServiceConstruction,
+		OrganizationID:  "550e8400-e29b-41d4-a716-446655440021",
+		CreatorUsername: "user4",
+	})
+	require.NoError(t, err)
+
+	_, err = c.UpdateTenderStatus(ctx, tender.ID, entity.TenderPublished, "user4")
+	require.NoError(t, err)
+
+	bid, err := c.CreateBid(ctx, bidsDtos.CreateBidRequest{
+		Name:        "Disputed bid",
+		Description: "Bid of user3",
+		TenderID:    tender.ID,
+		AuthorType:  entity.AuthorUser,
+		AuthorID:    authorID,
+	})
+	require.NoError(t, err)
+
+	_, err = c.UpdateBidStatus(ctx, bid.ID, entity.BidPublished, "user3")
+	require.NoError(t, err)
+
+	rating := 1
+	_, err = c.SendRatedFeedback(ctx, bid.ID, "Never delivered", "user4", bidsDtos.FeedbackRatings{Rating: &rating})
+	require.NoError(t, err)
+
+	rating = 5
+	_, err = c.SendRatedFeedback(ctx, bid.ID, "Perfect", "user5", bidsDtos.FeedbackRatings{Rating: &rating})
+	require.NoError(t, err)
+
+	reviews, err := c.Reviews(ctx, tender.ID, "user3", "user4", queryparams.Pagination{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, reviews, 2)
+	unfair, fair := reviews[1], reviews[0]
+	require.Equal(t, 1, unfair.Version)
+
+	// Only the bid author replies and disputes.
+	_, err = c.ReplyToReview(ctx, unfair.ID, "Delivered on time", "user4")
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	replied, err := c.ReplyToReview(ctx, unfair.ID, "Delivered on time, see the act", "user3")
+	require.NoError(t, err)
+	require.Equal(t, "Delivered on time, see the act", *replied.Reply)
+	require.NotNil(t, replied.RepliedAt)
+	require.Equal(t, 2, replied.Version)
+
+	disputed, err := c.DisputeReview(ctx, unfair.ID, "The review is false", "user3")
+	require.NoError(t, err)
+	require.Equal(t, entity.DisputeOpen, *disputed.DisputeStatus)
+	require.Equal(t, 3, disputed.Version)
+
+	_, err = c.DisputeReview(ctx, unfair.ID, "Once more", "user3")
+	require.ErrorIs(t, err, client.ErrInvalidInput)
+
+	// Disputed review is hidden pending resolution.
+	reviews, err = c.Reviews(ctx, tender.ID, "user3", "user4", queryparams.Pagination{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	require.Equal(t, fair.ID, reviews[0].ID)
+
+	// Only responsibles for the tender organization arbitrate.
+	_, err = c.ArbitrateReview(ctx, unfair.ID, entity.DisputeAccepted, "", "user3")
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	_, err = c.ArbitrateReview(ctx, unfair.ID, entity.DisputeOpen, "", "user5")
+	require.ErrorIs(t, err, client.ErrValidationFailed)
+
+	_, err = c.ArbitrateReview(ctx, fair.ID, entity.DisputeAccepted, "", "user5")
+	require.ErrorIs(t, err, client.ErrInvalidInput)
+
+	arbitrated, err := c.ArbitrateReview(ctx, unfair.ID, entity.DisputeAccepted, "The act is signed", "user5")
+	require.NoError(t, err)
+	require.Equal(t, entity.DisputeAccepted, *arbitrated.DisputeStatus)
+	require.Equal(t, 4, arbitrated.Version)
+
+	// Removed review is not counted in reputation.
+	reputation, err := c.SupplierReputation(ctx, authorID, "user4")
+	require.NoError(t, err)
+	require.Equal(t, 1, reputation.ReviewsCount)
+	require.InDelta(t, 5, *reputation.Rating, 0.001)
+
+	history, err := c.ReviewHistory(ctx, unfair.ID, "user3")
+	require.NoError(t, err)
+	require.Len(t, history, 4)
+	for i, action := range []entity.ReviewAction{
+		entity.ReviewCreated, entity.ReviewReplied, entity.ReviewDisputed, entity.ReviewArbitrated,
+	} {
+		require.Equal(t, action, history[i].Action)
+		require.Equal(t, i+1, history[i].Version)
+	}
+	require.Equal(t, "user5", history[3].ActorUsername)
+	require.Equal(t, "The act is signed", *history[3].Comment)
+
+	_, err = c.ReviewHistory(ctx, unfair.ID, "user7")
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	summary, err := c.ReviewsSummary(ctx, tender.ID, "user3", "user4")
+	require.NoError(t, err)
+	require.Equal(t, 1, summary.ReviewsCount)
+}
@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"avito-tenders/internal/entity"
+	"avito-tenders/internal/seed"
+)
+
+func TestSeedIsDeterministic(t *testing.T) {
+	first, err := seed.Generate(seed.DefaultOpts)
+	require.NoError(t, err)
+
+	second, err := seed.Generate(seed.DefaultOpts)
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+
+	opts := seed.DefaultOpts
+	opts.Seed++
+	other, err := seed.Generate(opts)
+	require.NoError(t, err)
+	require.NotEqual(t, first.Employees, other.Employees)
+
+	require.Len(t, first.Employees, seed.DefaultOpts.Employees)
+	require.Len(t, first.Organizations, seed.DefaultOpts.Organizations)
+	require.Len(t, first.Tenders, seed.DefaultOpts.Organizations*seed.DefaultOpts.TendersPerOrganization)
+}
+
+func TestSeedRejectsInvalidOpts(t *testing.T) {
+	opts := seed.DefaultOpts
+	opts.Organizations = opts.Employees + 1
+
+	_, err := seed.Generate(opts)
+	require.Error(t, err)
+}
+
+func (s *TestSuite) TestSeedInsert() {
+	t := s.T()
+	ctx := context.Background()
+
+	ds, err := seed.Generate(seed.Opts{
+		Seed:                   42,
+		Employees:              30,
+		Organizations:          5,
+		MaxMembers:             4,
+		TendersPerOrganization: 4,
+		MaxBidsPerTender:       4,
+		ReviewRatio:            0.5,
+	})
+	require.NoError(t, err)
+
+	// Seeded data is rolled back, so it does not affect other tests.
+	tx, err := s.back.DB.BeginTxx(ctx, nil)
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	require.NoError(t, seed.Insert(ctx, tx, ds))
+	// Repeated seed with the same data is no-op.
+	require.NoError(t, seed.Insert(ctx, tx, ds))
+
+	var tenders int
+	require.NoError(t, tx.GetContext(ctx, &tenders,
+		`select count(*) from tenders where organization_id = $1`, ds.Organizations[0].ID))
+	require.Equal(t, 4, tenders)
+
+	// Every approved bid has quorum of approvals from the tender organization.
+	var approved []string
+	for _, bid := range ds.Bids {
+		if bid.Status == entity.BidApproved {
+			approved = append(approved, bid.ID)
+		}
+	}
+	for _, bidID := range approved {
+		var amount int
+		require.NoError(t, tx.GetContext(ctx, &amount, `
+			select count(*)
+			from bids_approvals ba
+			    join bids b on b.id = ba.bid_id
+			    join tenders t on t.id = b.tender_id
+			    join organization_responsible o on o.organization_id = t.organization_id and o.user_id = ba.user_id
+			where ba.bid_id = $1`, bidID))
+		require.GreaterOrEqual(t, amount, 1)
+	}
+}
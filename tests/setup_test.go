@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	bidsDtos "avito-tenders/internal/api/bids/dtos"
+	tendersDtos "avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/client"
+)
+
+// userQuery returns query with username of the requester.
+func userQuery(username string) url.Values {
+	return url.Values{"username": []string{username}}
+}
+
+// loadBody returns the fixture as request body.
+func (s *TestSuite) loadBody(name string) io.Reader {
+	return bytes.NewBufferString(s.loader.LoadString(fmt.Sprintf("%s/%s", fixturesPath, name)))
+}
+
+// loadTemplateBody returns the fixture template filled with data as request body.
+func (s *TestSuite) loadTemplateBody(name string, data map[string]interface{}) io.Reader {
+	return bytes.NewBufferString(s.loader.LoadTemplate(fmt.Sprintf("%s/%s", fixturesPath, name), data))
+}
+
+// loadFixture decodes the fixture template filled with data into value of type T.
+func loadFixture[T any](s *TestSuite, t *testing.T, name string, data map[string]interface{}) T {
+	var v T
+	require.NoError(t, json.NewDecoder(s.loadTemplateBody(name, data)).Decode(&v))
+
+	return v
+}
+
+// newClient returns client of the test server.
+func (s *TestSuite) newClient(opts ...client.Option) *client.Client {
+	return client.New(s.server.URL, append([]client.Option{client.HTTPClient(s.server.Client())}, opts...)...)
+}
+
+// createTender creates tender of the fixture, the fixture status is kept, so published tenders are open for bids.
+func (s *TestSuite) createTender(t *testing.T, c *client.Client, name string) tendersDtos.TenderResponse {
+	tender, err := c.CreateTender(context.Background(), loadFixture[tendersDtos.CreateTenderRequest](s, t, name, nil))
+	require.NoError(t, err)
+
+	return tender
+}
+
+// createPublishedBid creates bid of the fixture template filled with data and publishes it on behalf of the author.
+func (s *TestSuite) createPublishedBid(t *testing.T, c *client.Client, name, username string, data map[string]interface{}) bidsDtos.BidResponse {
+	ctx := context.Background()
+
+	bid, err := c.CreateBid(ctx, loadFixture[bidsDtos.CreateBidRequest](s, t, name, data))
+	require.NoError(t, err)
+
+	bid, err = c.UpdateBidStatus(ctx, bid.ID, entity.BidPublished, username)
+	require.NoError(t, err)
+
+	return bid
+}
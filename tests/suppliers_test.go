@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/require"
+
+	bidsDtos "avito-tenders/internal/api/bids/dtos"
+	tendersDtos "avito-tenders/internal/api/tenders/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/client"
+	"avito-tenders/pkg/queryparams"
+)
+
+func (s *TestSuite) TestSupplierReputation() {
+	t := s.T()
+	ctx := context.Background()
+	c := s.newClient()
+
+	const authorID = "550e8400-e29b-41d4-a716-446655440002"
+
+	tender, err := c.CreateTender(ctx, tendersDtos.CreateTenderRequest{
+		Name:            "Rated tender",
+		Description:     "Bids of the tender are rated",
+		ServiceType:     entity.ServiceDelivery,
+		OrganizationID:  "550e8400-e29b-41d4-a716-446655440021",
+		CreatorUsername: "user4",
+	})
+	require.NoError(t, err)
+
+	_, err = c.UpdateTenderStatus(ctx, tender.ID, entity.TenderPublished, "user4")
+	require.NoError(t, err)
+
+	bid, err := c.CreateBid(ctx, bidsDtos.CreateBidRequest{
+		Name:        "Rated bid",
+		Description: "Bid of user2",
+		TenderID:    tender.ID,
+		AuthorType:  entity.AuthorUser,
+		AuthorID:    authorID,
+	})
+	require.NoError(t, err)
+
+	_, err = c.UpdateBidStatus(ctx, bid.ID, entity.BidPublished, "user2")
+	require.NoError(t, err)
+
+	// Supplier without reviews has empty reputation.
+	reputation, err := c.SupplierReputation(ctx, authorID, "user4")
+	require.NoError(t, err)
+	require.Equal(t, entity.AuthorUser, reputation.SupplierType)
+	require.Zero(t, reputation.ReviewsCount)
+	require.Nil(t, reputation.Rating)
+
+	rating, quality, timeliness := 5, 4, 2
+	bid, err = c.SendRatedFeedback(ctx, bid.ID, "Fast and accurate", "user4", bidsDtos.FeedbackRatings{
+		Rating:     &rating,
+		Quality:    &quality,
+		Timeliness: &timeliness,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, bid.Reputation)
+	require.Equal(t, 1, bid.Reputation.ReviewsCount)
+	require.InDelta(t, 5, *bid.Reputation.Rating, 0.001)
+	require.Nil(t, bid.Reputation.Communication)
+
+	rating, quality = 2, 3
+	_, err = c.SendRatedFeedback(ctx, bid.ID, "Packaging was damaged", "user5", bidsDtos.FeedbackRatings{
+		Rating:  &rating,
+		Quality: &quality,
+	})
+	require.NoError(t, err)
+
+	// Reviews without marks are counted, but do not affect average ratings.
+	_, err = c.SendFeedback(ctx, bid.ID, "No comments", "user6")
+	require.NoError(t, err)
+
+	reputation, err = c.SupplierReputation(ctx, authorID, "user4")
+	require.NoError(t, err)
+	require.Equal(t, authorID, reputation.SupplierID)
+	require.Equal(t, 3, reputation.ReviewsCount)
+	require.Equal(t, 2, reputation.RatingsCount)
+	require.InDelta(t, 3.5, *reputation.Rating, 0.001)
+	require.InDelta(t, 3.5, *reputation.Quality, 0.001)
+	require.InDelta(t, 2, *reputation.Timeliness, 0.001)
+	require.Nil(t, reputation.Communication)
+	require.NotNil(t, reputation.UpdatedAt)
+
+	tenderBids, err := c.TenderBids(ctx, tender.ID, "user4", queryparams.Pagination{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, tenderBids, 1)
+	require.Equal(t, reputation, *tenderBids[0].Reputation)
+
+	reviews, err := c.Reviews(ctx, tender.ID, "user2", "user4", queryparams.Pagination{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, reviews, 3)
+	require.Nil(t, reviews[0].Rating)
+	require.Equal(t, 2, *reviews[1].Rating)
+
+	// Marks are from 1 to 5, the review is not saved otherwise.
+	rating = 6
+	_, err = c.SendRatedFeedback(ctx, bid.ID, "Too good", "user4", bidsDtos.FeedbackRatings{Rating: &rating})
+	require.ErrorIs(t, err, client.ErrValidationFailed)
+
+	_, err = c.SupplierReputation(ctx, "550e8400-e29b-41d4-a716-446655449999", "user4")
+	require.ErrorIs(t, err, client.ErrNotFound)
+
+	_, err = c.SupplierReputation(ctx, "not-an-id", "user4")
+	require.ErrorIs(t, err, client.ErrInvalidInput)
+}
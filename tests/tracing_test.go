@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func (s *TestSuite) TestTracing() {
+	t := s.T()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		require.NoError(t, provider.Shutdown(context.Background()))
+	})
+
+	const (
+		traceID      = "4bf92f3577b34da6a3ce929d0e0e4736"
+		parentSpanID = "00f067aa0ba902b7"
+	)
+
+	req, err := http.NewRequest(http.MethodGet,
+		s.server.URL+"/api/tenders/550e8400-e29b-41d4-a716-446655440041/status?"+url.Values{"username": []string{"user1"}}.Encode(), nil)
+	require.NoError(t, err)
+	req.Header.Set("traceparent", "00-"+traceID+"-"+parentSpanID+"-01")
+
+	res, err := s.server.Client().Do(req)
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	names := make(map[string]struct{})
+	for _, span := range exporter.GetSpans() {
+		// Every span continues trace of the incoming request.
+		require.Equal(t, traceID, span.SpanContext.TraceID().String())
+
+		names[span.Name] = struct{}{}
+	}
+
+	require.Contains(t, names, "GET /api/tenders/{tenderId}/status")
+	require.Contains(t, names, "tenders.GetTenderStatus")
+	require.Contains(t, names, "db.transaction")
+
+	hasQuery := false
+	for name := range names {
+		if strings.HasPrefix(name, "sql.") {
+			hasQuery = true
+		}
+	}
+	require.True(t, hasQuery, "query spans are not found in %v", names)
+}
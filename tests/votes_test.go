@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/require"
+
+	bidsDtos "avito-tenders/internal/api/bids/dtos"
+	"avito-tenders/internal/entity"
+	"avito-tenders/pkg/client"
+)
+
+func (s *TestSuite) TestBidVotes() {
+	t := s.T()
+	ctx := context.Background()
+	c := s.newClient()
+
+	tender := s.createTender(t, c, "votes/create_tender.json")
+
+	newBid := func(name string) bidsDtos.BidResponse {
+		return s.createPublishedBid(t, c, "votes/create_bid.json", "user1", map[string]interface{}{
+			"name":     name,
+			"tenderId": tender.ID,
+		})
+	}
+	approved, rejected := newBid("Approved bid"), newBid("Rejected bid")
+
+	_, err := c.SubmitCommentedDecision(ctx, approved.ID, entity.DecisionApproved, "Cheapest offer", "user4")
+	require.NoError(t, err)
+
+	// One rejection does not reject the bid anymore.
+	bid, err := c.SubmitDecision(ctx, approved.ID, entity.DecisionRejected, "user5")
+	require.NoError(t, err)
+	require.Equal(t, entity.BidPublished, bid.Status)
+
+	_, err = c.SubmitDecision(ctx, approved.ID, entity.DecisionAbstain, "user6")
+	require.NoError(t, err)
+
+	tally, err := c.VoteTally(ctx, approved.ID, "user4")
+	require.NoError(t, err)
+	require.Equal(t, 3, tally.Quorum)
+	require.Equal(t, 1, tally.Approved)
+	require.Equal(t, 1, tally.Rejected)
+	require.Equal(t, 1, tally.Abstained)
+	require.Len(t, tally.Votes, 3)
+	require.Equal(t, "user4", tally.Votes[0].Username)
+	require.Equal(t, "Cheapest offer", *tally.Votes[0].Comment)
+
+	// Votes are changed and retracted before quorum is reached.
+	_, err = c.SubmitDecision(ctx, approved.ID, entity.DecisionApproved, "user5")
+	require.NoError(t, err)
+
+	_, err = c.RetractDecision(ctx, approved.ID, "user6")
+	require.NoError(t, err)
+
+	_, err = c.RetractDecision(ctx, approved.ID, "user6")
+	require.ErrorIs(t, err, client.ErrNotFound)
+
+	tally, err = c.VoteTally(ctx, approved.ID, "user5")
+	require.NoError(t, err)
+	require.Equal(t, 2, tally.Approved)
+	require.Zero(t, tally.Rejected)
+	require.Len(t, tally.Votes, 2)
+
+	bid, err = c.SubmitDecision(ctx, approved.ID, entity.DecisionApproved, "user6")
+	require.NoError(t, err)
+	require.Equal(t, entity.BidApproved, bid.Status)
+
+	// Decided bid is not voted anymore.
+	_, err = c.RetractDecision(ctx, approved.ID, "user4")
+	require.ErrorIs(t, err, client.ErrInvalidInput)
+
+	_, err = c.SubmitDecision(ctx, approved.ID, entity.DecisionRejected, "user4")
+	require.ErrorIs(t, err, client.ErrInvalidInput)
+
+	for _, username := range []string{"user4", "user5", "user6"} {
+		bid, err = c.SubmitDecision(ctx, rejected.ID, entity.DecisionRejected, username)
+		require.NoError(t, err)
+	}
+	require.Equal(t, entity.BidRejected, bid.Status)
+
+	_, err = c.VoteTally(ctx, approved.ID, "user1")
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	_, err = c.SubmitDecision(ctx, approved.ID, "Maybe", "user4")
+	require.ErrorIs(t, err, client.ErrValidationFailed)
+}